@@ -46,14 +46,24 @@ func Read(ctx context.Context, client *swo.Client, id string) *swo.ReadDashboard
 }
 
 func Update(ctx context.Context, client *swo.Client, dashboard swo.ReadDashboardResult) *swo.UpdateDashboardResult {
-	input, err := swo.ConvertObject[swo.UpdateDashboardInput](dashboard)
+	doc, err := dashboard.Document().CreateInput()
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	input.Name += "->[UPDATE_DASHBOARD]"
+	input := swo.UpdateDashboardInput{
+		Id:          dashboard.Id,
+		Name:        doc.Name + "->[UPDATE_DASHBOARD]",
+		Description: doc.Description,
+		Widgets:     doc.Widgets,
+		Layout:      doc.Layout,
+		Version:     doc.Version,
+	}
+	if dashboard.Category != nil {
+		input.CategoryId = &dashboard.Category.Id
+	}
 
-	result, err := client.DashboardsService().Update(ctx, *input)
+	result, err := client.DashboardsService().Update(ctx, input)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -0,0 +1,108 @@
+package client
+
+import (
+	"time"
+)
+
+// AlertInstanceDefinition identifies the alert definition an alert instance belongs to.
+type AlertInstanceDefinition struct {
+	Id       string        `json:"id"`
+	Name     string        `json:"name"`
+	Severity AlertSeverity `json:"severity"`
+}
+
+// AlertInstanceEntity describes the entity an alert instance is about.
+type AlertInstanceEntity struct {
+	Id          string  `json:"id"`
+	Name        *string `json:"name"`
+	DisplayName *string `json:"displayName"`
+	Type        *string `json:"type"`
+	HealthScore *int    `json:"healthScore"`
+}
+
+// AlertInstanceMuteDetail describes why and until when an alert instance is muted.
+type AlertInstanceMuteDetail struct {
+	Until  *string `json:"until"`
+	Reason string  `json:"reason"`
+}
+
+// AlertInstanceMuteInfo reports whether the notifications of an alert instance are muted.
+type AlertInstanceMuteInfo struct {
+	Muted   bool                      `json:"muted"`
+	Details []AlertInstanceMuteDetail `json:"details"`
+}
+
+// AlertInstanceUser identifies a user, e.g. the user who acknowledged an alert.
+type AlertInstanceUser struct {
+	Id string `json:"id"`
+}
+
+// AlertInstance is the evaluation of an alert definition for a single target, usually an entity.
+// Active alerts are the instances in the FIRING state.
+type AlertInstance struct {
+	// The GraphQL type of the instance, e.g. AlertMetricConditionEvaluationFlat.
+	Typename           string                   `json:"__typename"`
+	AlertDefinitionId  string                   `json:"alertDefinitionId"`
+	AlertDefinition    *AlertInstanceDefinition `json:"alertDefinition"`
+	Target             *AlertEvaluationTarget   `json:"target"`
+	State              AlertConditionState      `json:"state"`
+	ChangedAt          string                   `json:"changedAt"`
+	LastTriggeredAt    *string                  `json:"lastTriggeredAt"`
+	MuteInfo           AlertInstanceMuteInfo    `json:"muteInfo"`
+	Entity             *AlertInstanceEntity     `json:"entity"`
+	ChildEntity        *AlertInstanceEntity     `json:"childEntity"`
+	TriggerId          *string                  `json:"triggerId"`
+	AcknowledgedByUser *AlertInstanceUser       `json:"acknowledgedByUser"`
+}
+
+// Firing reports whether the instance is in the FIRING state.
+func (a *AlertInstance) Firing() bool {
+	return a.State == AlertConditionStateFiring
+}
+
+// Acknowledged reports whether a user has acknowledged the instance.
+func (a *AlertInstance) Acknowledged() bool {
+	return a.AcknowledgedByUser != nil
+}
+
+// HistoricalAlert is a past alert instance that has been resolved.
+type HistoricalAlert struct {
+	// The GraphQL type of the alert, e.g. HistoricalMetricAlert.
+	Typename          string                  `json:"__typename"`
+	AlertDefinitionId string                  `json:"alertDefinitionId"`
+	AlertDefinition   AlertInstanceDefinition `json:"alertDefinition"`
+	TriggeredAt       string                  `json:"triggeredAt"`
+	ResolvedAt        string                  `json:"resolvedAt"`
+	// Why the alert was resolved: AUTO_RESET, MANUAL_RESET, ENTITY_DELETED or ALERT_DEFINITION_DELETED.
+	Reason string                 `json:"reason"`
+	Target *AlertEvaluationTarget `json:"target"`
+	Entity *AlertInstanceEntity   `json:"entity"`
+}
+
+// Duration returns how long the alert was firing, or zero if the timestamps cannot be parsed.
+func (a *HistoricalAlert) Duration() time.Duration {
+	triggered, err := time.Parse(time.RFC3339, a.TriggeredAt)
+	if err != nil {
+		return 0
+	}
+	resolved, err := time.Parse(time.RFC3339, a.ResolvedAt)
+	if err != nil {
+		return 0
+	}
+	return resolved.Sub(triggered)
+}
+
+// AlertTimeRange returns a time range from start to end. A zero end leaves the range open, up to
+// the current time.
+func AlertTimeRange(start time.Time, end time.Time) AlertTimeRangeInput {
+	timeRange := AlertTimeRangeInput{StartTime: start.UTC().Format(time.RFC3339)}
+	if !end.IsZero() {
+		timeRange.EndTime = Ptr(end.UTC().Format(time.RFC3339))
+	}
+	return timeRange
+}
+
+// AlertTimeRangeLast returns a time range covering the given duration up to now, e.g. the last 24 hours.
+func AlertTimeRangeLast(d time.Duration) AlertTimeRangeInput {
+	return AlertTimeRange(time.Now().Add(-d), time.Time{})
+}
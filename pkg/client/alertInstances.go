@@ -0,0 +1,121 @@
+package client
+
+import (
+	"context"
+)
+
+type AlertInstancesService service
+
+type AlertInstancesPaginator = Paginator[*AlertInstancesResult, AlertInstance]
+type HistoricalAlertsPaginator = Paginator[*HistoricalAlertsResult, HistoricalAlert]
+
+type AlertInstancesCommunicator interface {
+	Active(context.Context, ActiveAlertsFilterInput, *ListOptions) (*AlertInstancesResult, error)
+	ActiveAll(context.Context, ActiveAlertsFilterInput, *ListOptions) *AlertInstancesPaginator
+	Historical(context.Context, HistoricalAlertsFilterInput, *ListOptions) (*HistoricalAlertsResult, error)
+	HistoricalAll(context.Context, HistoricalAlertsFilterInput, *ListOptions) *HistoricalAlertsPaginator
+	EvaluationsForDefinition(context.Context, string, AlertEvaluationsFilterInput, *ListOptions) (*AlertInstancesResult, error)
+	EvaluationsForDefinitionAll(context.Context, string, AlertEvaluationsFilterInput, *ListOptions) *AlertInstancesPaginator
+	MostTriggeredEntities(context.Context, string, AlertTimeRangeInput) ([]AlertEntityTriggerCount, error)
+}
+
+func newAlertInstancesService(c *Client) *AlertInstancesService {
+	return &AlertInstancesService{c}
+}
+
+// Active returns a single page of the alert instances that are currently firing and match the
+// given filter.
+func (s *AlertInstancesService) Active(ctx context.Context, filter ActiveAlertsFilterInput, opts *ListOptions) (*AlertInstancesResult, error) {
+	s.client.logger.DebugContext(ctx, "list active alerts request")
+
+	resp, err := listActiveAlerts(ctx, s.client.gql, filter, opts.paging(), opts.sortBy())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &resp.AlertQueries.ActiveAlerts
+	s.client.logger.DebugContext(ctx, "list active alerts success", "count", len(result.ConditionEvaluations))
+	return result, nil
+}
+
+// ActiveAll returns a paginator over every firing alert instance matching the given filter.
+func (s *AlertInstancesService) ActiveAll(ctx context.Context, filter ActiveAlertsFilterInput, opts *ListOptions) *AlertInstancesPaginator {
+	return NewPaginator(ctx,
+		func(ctx context.Context, paging *PagingInput) (*AlertInstancesResult, error) {
+			return s.Active(ctx, filter, &ListOptions{Paging: paging, SortBy: opts.sortBy()})
+		},
+		func(page *AlertInstancesResult) []AlertInstance {
+			return page.ConditionEvaluations
+		},
+		opts.paginatorOptions()...)
+}
+
+// Historical returns a single page of the resolved alerts matching the given filter. The filter
+// must contain a time range.
+func (s *AlertInstancesService) Historical(ctx context.Context, filter HistoricalAlertsFilterInput, opts *ListOptions) (*HistoricalAlertsResult, error) {
+	s.client.logger.DebugContext(ctx, "list historical alerts request", "start_time", filter.TimeRange.StartTime)
+
+	resp, err := listHistoricalAlerts(ctx, s.client.gql, filter, opts.paging(), opts.sortBy())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &resp.AlertQueries.HistoricalAlerts
+	s.client.logger.DebugContext(ctx, "list historical alerts success", "count", len(result.Alerts))
+	return result, nil
+}
+
+// HistoricalAll returns a paginator over every resolved alert matching the given filter.
+func (s *AlertInstancesService) HistoricalAll(ctx context.Context, filter HistoricalAlertsFilterInput, opts *ListOptions) *HistoricalAlertsPaginator {
+	return NewPaginator(ctx,
+		func(ctx context.Context, paging *PagingInput) (*HistoricalAlertsResult, error) {
+			return s.Historical(ctx, filter, &ListOptions{Paging: paging, SortBy: opts.sortBy()})
+		},
+		func(page *HistoricalAlertsResult) []HistoricalAlert {
+			return page.Alerts
+		},
+		opts.paginatorOptions()...)
+}
+
+// EvaluationsForDefinition returns a single page of the alert instances of the alert definition
+// with the given id, in any state.
+func (s *AlertInstancesService) EvaluationsForDefinition(ctx context.Context, id string, filter AlertEvaluationsFilterInput, opts *ListOptions) (*AlertInstancesResult, error) {
+	s.client.logger.DebugContext(ctx, "list alert evaluations request", "id", id)
+
+	resp, err := listAlertEvaluations(ctx, s.client.gql, id, filter, opts.paging(), opts.sortBy())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &resp.AlertQueries.AlertEvaluationsV2
+	s.client.logger.DebugContext(ctx, "list alert evaluations success", "id", id, "count", len(result.ConditionEvaluations))
+	return result, nil
+}
+
+// EvaluationsForDefinitionAll returns a paginator over every alert instance of the alert
+// definition with the given id.
+func (s *AlertInstancesService) EvaluationsForDefinitionAll(ctx context.Context, id string, filter AlertEvaluationsFilterInput, opts *ListOptions) *AlertInstancesPaginator {
+	return NewPaginator(ctx,
+		func(ctx context.Context, paging *PagingInput) (*AlertInstancesResult, error) {
+			return s.EvaluationsForDefinition(ctx, id, filter, &ListOptions{Paging: paging, SortBy: opts.sortBy()})
+		},
+		func(page *AlertInstancesResult) []AlertInstance {
+			return page.ConditionEvaluations
+		},
+		opts.paginatorOptions()...)
+}
+
+// MostTriggeredEntities returns the entities that triggered the alert definition with the given id
+// most often within the time range. The server returns at most 5 entities.
+func (s *AlertInstancesService) MostTriggeredEntities(ctx context.Context, id string, timeRange AlertTimeRangeInput) ([]AlertEntityTriggerCount, error) {
+	s.client.logger.DebugContext(ctx, "get most triggered entities request", "id", id)
+
+	resp, err := getMostTriggeredEntities(ctx, s.client.gql, id, timeRange)
+	if err != nil {
+		return nil, err
+	}
+
+	result := resp.AlertQueries.MostTriggeredEntities
+	s.client.logger.DebugContext(ctx, "get most triggered entities success", "id", id, "count", len(result))
+	return result, nil
+}
@@ -0,0 +1,278 @@
+package client
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+const mockAlertInstanceJSON = `{
+	"__typename": "AlertMetricConditionEvaluationFlat",
+	"alertDefinitionId": "a-1",
+	"alertDefinition": {"id": "a-1", "name": "High CPU", "severity": "CRITICAL"},
+	"target": {"entityId": "e-1", "tags": [{"key": "host", "value": "web-01"}]},
+	"state": "FIRING",
+	"changedAt": "2024-01-01T00:00:00Z",
+	"lastTriggeredAt": "2024-01-01T00:00:00Z",
+	"muteInfo": {"muted": false, "details": []},
+	"entity": {"id": "e-1", "name": "web-01", "displayName": "Web 01", "type": "Host", "healthScore": 50},
+	"triggerId": "t-1",
+	"acknowledgedByUser": null
+}`
+
+const mockHistoricalAlertJSON = `{
+	"__typename": "HistoricalMetricAlert",
+	"alertDefinitionId": "a-1",
+	"alertDefinition": {"id": "a-1", "name": "High CPU", "severity": "CRITICAL"},
+	"triggeredAt": "2024-01-01T00:00:00Z",
+	"resolvedAt": "2024-01-01T00:30:00Z",
+	"reason": "AUTO_RESET",
+	"target": {"entityId": "e-1"},
+	"entity": {"id": "e-1", "displayName": "Web 01", "type": "Host"}
+}`
+
+func mockAlertInstance() AlertInstance {
+	return AlertInstance{
+		Typename:          "AlertMetricConditionEvaluationFlat",
+		AlertDefinitionId: "a-1",
+		AlertDefinition:   &AlertInstanceDefinition{Id: "a-1", Name: "High CPU", Severity: AlertSeverityCritical},
+		Target: &AlertEvaluationTarget{
+			EntityId: Ptr("e-1"),
+			Tags:     []AlertEvaluationTag{{Key: "host", Value: "web-01"}},
+		},
+		State:           AlertConditionStateFiring,
+		ChangedAt:       "2024-01-01T00:00:00Z",
+		LastTriggeredAt: Ptr("2024-01-01T00:00:00Z"),
+		MuteInfo:        AlertInstanceMuteInfo{Details: []AlertInstanceMuteDetail{}},
+		Entity: &AlertInstanceEntity{
+			Id:          "e-1",
+			Name:        Ptr("web-01"),
+			DisplayName: Ptr("Web 01"),
+			Type:        Ptr("Host"),
+			HealthScore: Ptr(50),
+		},
+		TriggerId: Ptr("t-1"),
+	}
+}
+
+func TestAlertInstances_Active(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	filter := ActiveAlertsFilterInput{Severities: []AlertSeverity{AlertSeverityCritical}}
+	opts := &ListOptions{
+		Paging: &PagingInput{First: Ptr(10)},
+		SortBy: SortBy("lastTriggeredAt", SortDirectionDesc),
+	}
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__listActiveAlertsInput](r)
+		if err != nil {
+			t.Errorf("Swo.ListActiveAlerts returned error: %v", err)
+		}
+
+		want := __listActiveAlertsInput{Filter: filter, Paging: opts.Paging, SortBy: opts.SortBy}
+		if !testObjects(t, *gqlInput, want) {
+			t.Errorf("Request input = %+v, want %+v", *gqlInput, want)
+		}
+
+		sendGraphQLResponse(t, w, []byte(`{"data": {"alertQueries": {"activeAlerts": {
+			"conditionEvaluations": [`+mockAlertInstanceJSON+`],
+			"pageInfo": {"hasNextPage": false, "hasPreviousPage": false},
+			"totalRecords": 1
+		}}}}`))
+	})
+
+	got, err := client.AlertInstancesService().Active(ctx, filter, opts)
+	if err != nil {
+		t.Fatalf("Swo.ListActiveAlerts error: %v", err)
+	}
+
+	want := &AlertInstancesResult{
+		ConditionEvaluations: []AlertInstance{mockAlertInstance()},
+		TotalRecords:         Ptr(1),
+	}
+
+	if !testObjects(t, got, want) {
+		t.Errorf("Swo.ListActiveAlerts returned %+v, want %+v", got, want)
+	}
+	if instance := got.ConditionEvaluations[0]; !instance.Firing() || instance.Acknowledged() {
+		t.Errorf("Swo.ListActiveAlerts Firing() = %v, Acknowledged() = %v", instance.Firing(), instance.Acknowledged())
+	}
+}
+
+func TestAlertInstances_ActiveAll(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__listActiveAlertsInput](r)
+		if err != nil {
+			t.Errorf("Swo.ListAllActiveAlerts returned error: %v", err)
+		}
+
+		page := `{"hasNextPage": true, "endCursor": "1"}`
+		if gqlInput.Paging.After != nil {
+			page = `{"hasNextPage": false}`
+		}
+
+		sendGraphQLResponse(t, w, []byte(`{"data": {"alertQueries": {"activeAlerts": {
+			"conditionEvaluations": [`+mockAlertInstanceJSON+`],
+			"pageInfo": `+page+`
+		}}}}`))
+	})
+
+	got, err := client.AlertInstancesService().ActiveAll(ctx, ActiveAlertsFilterInput{}, &ListOptions{
+		Paging: &PagingInput{First: Ptr(1)},
+	}).Collect()
+	if err != nil {
+		t.Fatalf("Swo.ListAllActiveAlerts error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Errorf("Swo.ListAllActiveAlerts returned %d alerts, want 2", len(got))
+	}
+}
+
+func TestAlertInstances_Historical(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	filter := HistoricalAlertsFilterInput{
+		TimeRange:          AlertTimeRange(start, start.Add(24*time.Hour)),
+		AlertDefinitionIds: []string{"a-1"},
+	}
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__listHistoricalAlertsInput](r)
+		if err != nil {
+			t.Errorf("Swo.ListHistoricalAlerts returned error: %v", err)
+		}
+
+		want := AlertTimeRangeInput{StartTime: "2024-01-01T00:00:00Z", EndTime: Ptr("2024-01-02T00:00:00Z")}
+		if !testObjects(t, gqlInput.Filter.TimeRange, want) {
+			t.Errorf("Request time range = %+v, want %+v", gqlInput.Filter.TimeRange, want)
+		}
+
+		sendGraphQLResponse(t, w, []byte(`{"data": {"alertQueries": {"historicalAlerts": {
+			"alerts": [`+mockHistoricalAlertJSON+`],
+			"pageInfo": {"hasNextPage": false, "hasPreviousPage": false},
+			"totalRecords": 1,
+			"unprocessedRecords": false
+		}}}}`))
+	})
+
+	got, err := client.AlertInstancesService().HistoricalAll(ctx, filter, nil).Collect()
+	if err != nil {
+		t.Fatalf("Swo.ListHistoricalAlerts error: %v", err)
+	}
+
+	want := []HistoricalAlert{{
+		Typename:          "HistoricalMetricAlert",
+		AlertDefinitionId: "a-1",
+		AlertDefinition:   AlertInstanceDefinition{Id: "a-1", Name: "High CPU", Severity: AlertSeverityCritical},
+		TriggeredAt:       "2024-01-01T00:00:00Z",
+		ResolvedAt:        "2024-01-01T00:30:00Z",
+		Reason:            "AUTO_RESET",
+		Target:            &AlertEvaluationTarget{EntityId: Ptr("e-1")},
+		Entity:            &AlertInstanceEntity{Id: "e-1", DisplayName: Ptr("Web 01"), Type: Ptr("Host")},
+	}}
+
+	if !testObjects(t, got, want) {
+		t.Errorf("Swo.ListHistoricalAlerts returned %+v, want %+v", got, want)
+	}
+	if duration := got[0].Duration(); duration != 30*time.Minute {
+		t.Errorf("HistoricalAlert.Duration() = %v, want 30m", duration)
+	}
+}
+
+func TestAlertInstances_EvaluationsForDefinition(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	filter := AlertEvaluationsFilterInput{State: Ptr(AlertConditionStateFiring)}
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__listAlertEvaluationsInput](r)
+		if err != nil {
+			t.Errorf("Swo.ListAlertEvaluations returned error: %v", err)
+		}
+
+		want := __listAlertEvaluationsInput{Id: "a-1", Filter: filter, Paging: &PagingInput{}}
+		if !testObjects(t, *gqlInput, want) {
+			t.Errorf("Request input = %+v, want %+v", *gqlInput, want)
+		}
+
+		sendGraphQLResponse(t, w, []byte(`{"data": {"alertQueries": {"alertEvaluationsV2": {
+			"conditionEvaluations": [`+mockAlertInstanceJSON+`],
+			"pageInfo": {"hasNextPage": false, "hasPreviousPage": false},
+			"totalRecords": 1
+		}}}}`))
+	})
+
+	got, err := client.AlertInstancesService().EvaluationsForDefinitionAll(ctx, "a-1", filter, nil).Collect()
+	if err != nil {
+		t.Fatalf("Swo.ListAlertEvaluations error: %v", err)
+	}
+
+	if want := []AlertInstance{mockAlertInstance()}; !testObjects(t, got, want) {
+		t.Errorf("Swo.ListAlertEvaluations returned %+v, want %+v", got, want)
+	}
+}
+
+func TestAlertInstances_MostTriggeredEntities(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	timeRange := AlertTimeRangeInput{StartTime: "2 days ago"}
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__getMostTriggeredEntitiesInput](r)
+		if err != nil {
+			t.Errorf("Swo.GetMostTriggeredEntities returned error: %v", err)
+		}
+
+		want := __getMostTriggeredEntitiesInput{Id: "a-1", TimeRange: timeRange}
+		if !testObjects(t, *gqlInput, want) {
+			t.Errorf("Request input = %+v, want %+v", *gqlInput, want)
+		}
+
+		sendGraphQLResponse(t, w, getMostTriggeredEntitiesResponse{
+			AlertQueries: getMostTriggeredEntitiesAlertQueries{
+				MostTriggeredEntities: []AlertEntityTriggerCount{
+					{Id: "e-1", DisplayName: "Web 01", TriggerCount: 3},
+				},
+			},
+		})
+	})
+
+	got, err := client.AlertInstancesService().MostTriggeredEntities(ctx, "a-1", timeRange)
+	if err != nil {
+		t.Fatalf("Swo.GetMostTriggeredEntities error: %v", err)
+	}
+
+	want := []AlertEntityTriggerCount{{Id: "e-1", DisplayName: "Web 01", TriggerCount: 3}}
+	if !testObjects(t, got, want) {
+		t.Errorf("Swo.GetMostTriggeredEntities returned %+v, want %+v", got, want)
+	}
+}
+
+func TestAlertInstances_ServerErrors(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", httpErrorResponse)
+
+	if _, err := client.AlertInstancesService().Active(ctx, ActiveAlertsFilterInput{}, nil); err == nil {
+		t.Error("Swo.AlertInstancesServerErrors expected an error response")
+	}
+	if _, err := client.AlertInstancesService().Historical(ctx, HistoricalAlertsFilterInput{}, nil); err == nil {
+		t.Error("Swo.AlertInstancesServerErrors expected an error response")
+	}
+	if _, err := client.AlertInstancesService().EvaluationsForDefinition(ctx, "a-1", AlertEvaluationsFilterInput{}, nil); err == nil {
+		t.Error("Swo.AlertInstancesServerErrors expected an error response")
+	}
+	if _, err := client.AlertInstancesService().MostTriggeredEntities(ctx, "a-1", AlertTimeRangeInput{}); err == nil {
+		t.Error("Swo.AlertInstancesServerErrors expected an error response")
+	}
+}
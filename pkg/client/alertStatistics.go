@@ -0,0 +1,167 @@
+package client
+
+import (
+	"context"
+)
+
+type AlertManagementEventsPaginator = Paginator[*AlertManagementEventsResult, AlertManagementEvent]
+
+// AlertManagementEventUser identifies the user who caused an alert management event.
+type AlertManagementEventUser struct {
+	Id    string `json:"id"`
+	Email string `json:"email"`
+}
+
+// AlertDefinitionEventData is the configuration of an alert definition before or after an update.
+type AlertDefinitionEventData struct {
+	Name              string        `json:"name"`
+	Description       *string       `json:"description"`
+	Severity          AlertSeverity `json:"severity"`
+	TargetEntityTypes []string      `json:"targetEntityTypes"`
+}
+
+// AlertManagementEvent records a change to an alert definition, e.g. who created, updated or
+// muted it. The fields that only apply to some kinds of events are nil for the others.
+type AlertManagementEvent struct {
+	// The GraphQL type of the event, e.g. AlertDefinitionUpdatedEvent.
+	Typename          string                    `json:"__typename"`
+	EventId           string                    `json:"eventId"`
+	Timestamp         string                    `json:"timestamp"`
+	AlertDefinitionId string                    `json:"alertDefinitionId"`
+	AlertDefinition   *AlertInstanceDefinition  `json:"alertDefinition"`
+	UserId            *string                   `json:"userId"`
+	User              *AlertManagementEventUser `json:"user"`
+	// Whether the event was caused by a USER or the SYSTEM.
+	EventSource *string `json:"eventSource"`
+	// Set for muted events.
+	ExpiresAt *string `json:"expiresAt,omitempty"`
+	// Set for muted and unmuted events.
+	Entity *AlertInstanceEntity `json:"entity,omitempty"`
+	// Set for updated events.
+	OriginalConfiguration *AlertDefinitionEventData `json:"originalConfiguration,omitempty"`
+	NewConfiguration      *AlertDefinitionEventData `json:"newConfiguration,omitempty"`
+}
+
+// The event type of each GraphQL alert management event type.
+var alertManagementEventTypes = map[string]AlertManagementEventType{
+	"AlertDefinitionCreatedEvent":        AlertManagementEventTypeAlertDefinitionCreated,
+	"AlertDefinitionDeletedEvent":        AlertManagementEventTypeAlertDefinitionDeleted,
+	"AlertDefinitionDisabledEvent":       AlertManagementEventTypeAlertDefinitionDisabled,
+	"AlertDefinitionEnabledEvent":        AlertManagementEventTypeAlertDefinitionEnabled,
+	"AlertDefinitionManualResetEvent":    AlertManagementEventTypeAlertDefinitionManualReset,
+	"AlertDefinitionAutomaticResetEvent": AlertManagementEventTypeAlertDefinitionAutomaticReset,
+	"AlertDefinitionMutedEvent":          AlertManagementEventTypeAlertDefinitionMuted,
+	"AlertDefinitionUnmutedEvent":        AlertManagementEventTypeAlertDefinitionUnmuted,
+	"AlertDefinitionUpdatedEvent":        AlertManagementEventTypeAlertDefinitionUpdated,
+	"AlertDefinitionTriggeredEvent":      AlertManagementEventTypeAlertDefinitionTriggered,
+	"AlertActionDeletedByCnsEvent":       AlertManagementEventTypeAlertActionDeletedByCns,
+}
+
+// Type returns the type of the event, or an empty string if the event type is unknown.
+func (e *AlertManagementEvent) Type() AlertManagementEventType {
+	return alertManagementEventTypes[e.Typename]
+}
+
+// AlertStatisticsCount holds the number of items matching the filter and the total number of items.
+type AlertStatisticsCount struct {
+	ForFilter int
+	ForAll    int
+}
+
+func (c *AlertStatisticsCount) add(item AlertStatisticsItem) {
+	c.ForFilter += item.CountForFilter
+	c.ForAll += item.CountForAll
+}
+
+// AlertStatisticsSummary holds the statistics counts per severity and per condition type.
+type AlertStatisticsSummary struct {
+	BySeverity      map[AlertSeverity]AlertStatisticsCount
+	ByConditionType map[string]AlertStatisticsCount
+}
+
+// AggregateAlertStatistics sums the SEVERITY and CONDITION_TYPE statistics, e.g. to combine the
+// statistics of several requests. The other categories are ignored.
+func AggregateAlertStatistics(statistics ...[]AlertStatistics) AlertStatisticsSummary {
+	summary := AlertStatisticsSummary{
+		BySeverity:      map[AlertSeverity]AlertStatisticsCount{},
+		ByConditionType: map[string]AlertStatisticsCount{},
+	}
+
+	for _, stats := range statistics {
+		for _, stat := range stats {
+			for _, item := range stat.Items {
+				switch stat.Category {
+				case AlertStatisticsCategorySeverity:
+					count := summary.BySeverity[AlertSeverity(item.Name)]
+					count.add(item)
+					summary.BySeverity[AlertSeverity(item.Name)] = count
+				case AlertStatisticsCategoryConditionType:
+					count := summary.ByConditionType[item.Name]
+					count.add(item)
+					summary.ByConditionType[item.Name] = count
+				}
+			}
+		}
+	}
+
+	return summary
+}
+
+// Statistics returns the alert definition statistics of the given categories, both for every
+// definition and for the definitions matching the filter. Every category is returned if none
+// are given.
+func (as *AlertsService) Statistics(ctx context.Context, filter AlertFilterInput, categories ...AlertDefinitionStatisticsCategory) ([]AlertStatistics, error) {
+	as.client.logger.DebugContext(ctx, "get alert statistics request", "categories", categories)
+
+	resp, err := getAlertStatistics(ctx, as.client.gql, filter, categories)
+	if err != nil {
+		return nil, err
+	}
+
+	result := resp.AlertQueries.AlertStatistics
+	as.client.logger.DebugContext(ctx, "get alert statistics success", "count", len(result))
+	return result, nil
+}
+
+// EvaluationStatistics returns the alert evaluation statistics of the given categories, both for
+// every evaluation and for the evaluations matching the filter. Every category is returned if
+// none are given.
+func (as *AlertsService) EvaluationStatistics(ctx context.Context, filter AlertEvaluationFilterInput, categories ...AlertEvaluationStatisticsCategory) ([]AlertStatistics, error) {
+	as.client.logger.DebugContext(ctx, "get alert evaluation statistics request", "categories", categories)
+
+	resp, err := getAlertEvaluationStatistics(ctx, as.client.gql, filter, categories)
+	if err != nil {
+		return nil, err
+	}
+
+	result := resp.AlertQueries.EvaluationStatistics
+	as.client.logger.DebugContext(ctx, "get alert evaluation statistics success", "count", len(result))
+	return result, nil
+}
+
+// ManagementEvents returns a single page of the alert management events matching the given
+// filter. The filter must contain a time range.
+func (as *AlertsService) ManagementEvents(ctx context.Context, filter AlertManagementEventsFilterInput, opts *ListOptions) (*AlertManagementEventsResult, error) {
+	as.client.logger.DebugContext(ctx, "list alert management events request", "start_time", filter.TimeRange.StartTime)
+
+	resp, err := listAlertManagementEvents(ctx, as.client.gql, filter, opts.paging())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &resp.AlertQueries.AlertManagementEvents
+	as.client.logger.DebugContext(ctx, "list alert management events success", "count", len(result.Events))
+	return result, nil
+}
+
+// ManagementEventsAll returns a paginator over every alert management event matching the given filter.
+func (as *AlertsService) ManagementEventsAll(ctx context.Context, filter AlertManagementEventsFilterInput, opts *ListOptions) *AlertManagementEventsPaginator {
+	return NewPaginator(ctx,
+		func(ctx context.Context, paging *PagingInput) (*AlertManagementEventsResult, error) {
+			return as.ManagementEvents(ctx, filter, &ListOptions{Paging: paging})
+		},
+		func(page *AlertManagementEventsResult) []AlertManagementEvent {
+			return page.Events
+		},
+		opts.paginatorOptions()...)
+}
@@ -0,0 +1,211 @@
+package client
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestAlertStatistics(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	filter := AlertFilterInput{Enabled: Ptr(true)}
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__getAlertStatisticsInput](r)
+		if err != nil {
+			t.Errorf("Swo.GetAlertStatistics returned error: %v", err)
+		}
+
+		want := __getAlertStatisticsInput{
+			Filter:     filter,
+			Categories: []AlertDefinitionStatisticsCategory{AlertDefinitionStatisticsCategorySeverity},
+		}
+		if !testObjects(t, *gqlInput, want) {
+			t.Errorf("Request input = %+v, want %+v", *gqlInput, want)
+		}
+
+		sendGraphQLResponse(t, w, getAlertStatisticsResponse{
+			AlertQueries: getAlertStatisticsAlertQueries{
+				AlertStatistics: []AlertStatistics{{
+					Category: AlertStatisticsCategorySeverity,
+					Items:    []AlertStatisticsItem{{Name: "CRITICAL", CountForFilter: 2, CountForAll: 3}},
+				}},
+			},
+		})
+	})
+
+	got, err := client.AlertsService().Statistics(ctx, filter, AlertDefinitionStatisticsCategorySeverity)
+	if err != nil {
+		t.Fatalf("Swo.GetAlertStatistics error: %v", err)
+	}
+
+	want := []AlertStatistics{{
+		Category: AlertStatisticsCategorySeverity,
+		Items:    []AlertStatisticsItem{{Name: "CRITICAL", CountForFilter: 2, CountForAll: 3}},
+	}}
+	if !testObjects(t, got, want) {
+		t.Errorf("Swo.GetAlertStatistics returned %+v, want %+v", got, want)
+	}
+}
+
+func TestAlertEvaluationStatistics(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	filter := AlertEvaluationFilterInput{Active: Ptr(true)}
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__getAlertEvaluationStatisticsInput](r)
+		if err != nil {
+			t.Errorf("Swo.GetAlertEvaluationStatistics returned error: %v", err)
+		}
+
+		want := __getAlertEvaluationStatisticsInput{Filter: filter}
+		if !testObjects(t, *gqlInput, want) {
+			t.Errorf("Request input = %+v, want %+v", *gqlInput, want)
+		}
+
+		sendGraphQLResponse(t, w, getAlertEvaluationStatisticsResponse{
+			AlertQueries: getAlertEvaluationStatisticsAlertQueries{
+				EvaluationStatistics: []AlertStatistics{{
+					Category: AlertStatisticsCategoryMuted,
+					Items:    []AlertStatisticsItem{{Name: "true", CountForFilter: 1, CountForAll: 4}},
+				}},
+			},
+		})
+	})
+
+	got, err := client.AlertsService().EvaluationStatistics(ctx, filter)
+	if err != nil {
+		t.Fatalf("Swo.GetAlertEvaluationStatistics error: %v", err)
+	}
+	if len(got) != 1 || got[0].Category != AlertStatisticsCategoryMuted {
+		t.Errorf("Swo.GetAlertEvaluationStatistics returned %+v", got)
+	}
+}
+
+func TestAggregateAlertStatistics(t *testing.T) {
+	definitions := []AlertStatistics{
+		{
+			Category: AlertStatisticsCategorySeverity,
+			Items: []AlertStatisticsItem{
+				{Name: "CRITICAL", CountForFilter: 2, CountForAll: 3},
+				{Name: "INFO", CountForFilter: 0, CountForAll: 5},
+			},
+		},
+		{
+			Category: AlertStatisticsCategoryConditionType,
+			Items:    []AlertStatisticsItem{{Name: "METRIC", CountForFilter: 2, CountForAll: 8}},
+		},
+		{
+			Category: AlertStatisticsCategoryEnabled,
+			Items:    []AlertStatisticsItem{{Name: "true", CountForFilter: 2, CountForAll: 6}},
+		},
+	}
+	evaluations := []AlertStatistics{{
+		Category: AlertStatisticsCategorySeverity,
+		Items:    []AlertStatisticsItem{{Name: "CRITICAL", CountForFilter: 1, CountForAll: 1}},
+	}}
+
+	got := AggregateAlertStatistics(definitions, evaluations)
+
+	want := AlertStatisticsSummary{
+		BySeverity: map[AlertSeverity]AlertStatisticsCount{
+			AlertSeverityCritical: {ForFilter: 3, ForAll: 4},
+			AlertSeverityInfo:     {ForFilter: 0, ForAll: 5},
+		},
+		ByConditionType: map[string]AlertStatisticsCount{
+			"METRIC": {ForFilter: 2, ForAll: 8},
+		},
+	}
+	if !testObjects(t, got, want) {
+		t.Errorf("AggregateAlertStatistics returned %+v, want %+v", got, want)
+	}
+}
+
+func TestAlertManagementEvents(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	filter := AlertManagementEventsFilterInput{
+		TimeRange: AlertTimeRangeInput{StartTime: "7 days ago"},
+		Types:     []AlertManagementEventType{AlertManagementEventTypeAlertDefinitionUpdated},
+	}
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__listAlertManagementEventsInput](r)
+		if err != nil {
+			t.Errorf("Swo.ListAlertManagementEvents returned error: %v", err)
+		}
+		if !testObjects(t, gqlInput.Filter, filter) {
+			t.Errorf("Request filter = %+v, want %+v", gqlInput.Filter, filter)
+		}
+
+		event := `{
+			"__typename": "AlertDefinitionUpdatedEvent",
+			"eventId": "ev-1",
+			"timestamp": "2024-01-01T00:00:00Z",
+			"alertDefinitionId": "a-1",
+			"alertDefinition": {"id": "a-1", "name": "High CPU", "severity": "CRITICAL"},
+			"userId": "u-1",
+			"user": {"id": "u-1", "email": "user@example.com"},
+			"eventSource": "USER",
+			"originalConfiguration": {"name": "CPU", "severity": "WARNING"},
+			"newConfiguration": {"name": "High CPU", "severity": "CRITICAL"}
+		}`
+		page := `{"hasNextPage": true, "endCursor": "1"}`
+		if gqlInput.Paging.After != nil {
+			page = `{"hasNextPage": false}`
+		}
+
+		sendGraphQLResponse(t, w, []byte(`{"data": {"alertQueries": {"alertManagementEvents": {
+			"events": [`+event+`],
+			"pageInfo": `+page+`
+		}}}}`))
+	})
+
+	got, err := client.AlertsService().ManagementEventsAll(ctx, filter, nil).Collect()
+	if err != nil {
+		t.Fatalf("Swo.ListAlertManagementEvents error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("Swo.ListAlertManagementEvents returned %d events, want 2", len(got))
+	}
+
+	want := AlertManagementEvent{
+		Typename:              "AlertDefinitionUpdatedEvent",
+		EventId:               "ev-1",
+		Timestamp:             "2024-01-01T00:00:00Z",
+		AlertDefinitionId:     "a-1",
+		AlertDefinition:       &AlertInstanceDefinition{Id: "a-1", Name: "High CPU", Severity: AlertSeverityCritical},
+		UserId:                Ptr("u-1"),
+		User:                  &AlertManagementEventUser{Id: "u-1", Email: "user@example.com"},
+		EventSource:           Ptr("USER"),
+		OriginalConfiguration: &AlertDefinitionEventData{Name: "CPU", Severity: AlertSeverityWarning},
+		NewConfiguration:      &AlertDefinitionEventData{Name: "High CPU", Severity: AlertSeverityCritical},
+	}
+	if !testObjects(t, got[0], want) {
+		t.Errorf("Swo.ListAlertManagementEvents returned %+v, want %+v", got[0], want)
+	}
+	if got[0].Type() != AlertManagementEventTypeAlertDefinitionUpdated {
+		t.Errorf("AlertManagementEvent.Type() = %s, want %s", got[0].Type(), AlertManagementEventTypeAlertDefinitionUpdated)
+	}
+}
+
+func TestAlertStatistics_ServerErrors(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", httpErrorResponse)
+
+	if _, err := client.AlertsService().Statistics(ctx, AlertFilterInput{}); err == nil {
+		t.Error("Swo.AlertStatisticsServerErrors expected an error response")
+	}
+	if _, err := client.AlertsService().EvaluationStatistics(ctx, AlertEvaluationFilterInput{}); err == nil {
+		t.Error("Swo.AlertStatisticsServerErrors expected an error response")
+	}
+	if _, err := client.AlertsService().ManagementEvents(ctx, AlertManagementEventsFilterInput{}, nil); err == nil {
+		t.Error("Swo.AlertStatisticsServerErrors expected an error response")
+	}
+}
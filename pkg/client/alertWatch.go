@@ -0,0 +1,167 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+)
+
+// The longest wait between polls after repeated errors, unless the poll interval is longer.
+const maxAlertWatchBackoff = 5 * time.Minute
+
+// AlertEventType is the kind of state change reported by AlertsService.Watch.
+type AlertEventType string
+
+const (
+	// The alert instance started firing.
+	AlertEventTriggered AlertEventType = "TRIGGERED"
+	// The alert instance stopped firing.
+	AlertEventResolved AlertEventType = "RESOLVED"
+	// A user acknowledged the firing alert instance.
+	AlertEventAcknowledged AlertEventType = "ACKNOWLEDGED"
+	// The notifications of the firing alert instance were muted.
+	AlertEventMuted AlertEventType = "MUTED"
+)
+
+// AlertEvent is a state change of an active alert instance found by AlertsService.Watch.
+type AlertEvent struct {
+	Type AlertEventType
+	// The instance as seen by the poll that found the change. For resolved events this is the
+	// last poll in which the instance was still active.
+	Instance AlertInstance
+	// The time of the poll that found the change.
+	Time time.Time
+}
+
+// Watch polls the active alerts matching the filter every interval and emits an event on the
+// returned channel for every alert instance that is triggered, resolved, acknowledged or muted
+// between two polls. The first poll only records the alerts that are already active and returns
+// its error, if any. Later errors are logged and retried with an exponential backoff.
+//
+// The channel is closed once ctx is done. Callers must keep receiving from the channel, since
+// polling pauses until each event has been received.
+func (as *AlertsService) Watch(ctx context.Context, filter ActiveAlertsFilterInput, interval time.Duration) (<-chan AlertEvent, error) {
+	if interval <= 0 {
+		return nil, errors.New("alert watch interval must be positive")
+	}
+
+	as.client.logger.DebugContext(ctx, "watch alerts request", "interval", interval)
+
+	snapshot, err := as.activeAlertSnapshot(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan AlertEvent)
+	go func() {
+		defer close(events)
+
+		wait := interval
+		for {
+			select {
+			case <-ctx.Done():
+				as.client.logger.DebugContext(ctx, "watch alerts stopped")
+				return
+			case <-as.client.clock.After(wait):
+			}
+
+			current, err := as.activeAlertSnapshot(ctx, filter)
+			if err != nil {
+				if ctx.Err() != nil {
+					continue
+				}
+				wait = min(wait*2, max(maxAlertWatchBackoff, interval))
+				as.client.logger.WarnContext(ctx, "watch alerts poll failed", "error", err, "wait", wait)
+				continue
+			}
+			wait = interval
+
+			for _, event := range diffAlertSnapshots(snapshot, current, as.client.clock.Now()) {
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+			snapshot = current
+		}
+	}()
+
+	return events, nil
+}
+
+// activeAlertSnapshot returns every active alert matching the filter by its key. Instances
+// that appear on more than one page are only kept once.
+func (as *AlertsService) activeAlertSnapshot(ctx context.Context, filter ActiveAlertsFilterInput) (map[string]AlertInstance, error) {
+	instances, err := as.client.AlertInstancesService().ActiveAll(ctx, filter, nil).Collect()
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := make(map[string]AlertInstance, len(instances))
+	for _, instance := range instances {
+		snapshot[alertInstanceKey(instance)] = instance
+	}
+	return snapshot, nil
+}
+
+// alertInstanceKey identifies an alert instance across polls by its alert definition and target.
+func alertInstanceKey(instance AlertInstance) string {
+	parts := []string{instance.AlertDefinitionId}
+	if target := instance.Target; target != nil {
+		if target.EntityId != nil {
+			parts = append(parts, *target.EntityId)
+		}
+		if target.ChildEntityId != nil {
+			parts = append(parts, *target.ChildEntityId)
+		}
+		for _, tag := range target.Tags {
+			parts = append(parts, tag.Key+"="+tag.Value)
+		}
+	}
+	return strings.Join(parts, "|")
+}
+
+// diffAlertSnapshots returns the events that turn the previous snapshot into the current one,
+// ordered by alert instance key. An instance that was triggered again between the two polls is
+// reported as triggered.
+func diffAlertSnapshots(previous map[string]AlertInstance, current map[string]AlertInstance, now time.Time) []AlertEvent {
+	var events []AlertEvent
+	add := func(eventType AlertEventType, instance AlertInstance) {
+		events = append(events, AlertEvent{Type: eventType, Instance: instance, Time: now})
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(previous)) {
+		if _, ok := current[key]; !ok {
+			add(AlertEventResolved, previous[key])
+		}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(current)) {
+		instance := current[key]
+		before, ok := previous[key]
+		if !ok || !equalPtr(before.TriggerId, instance.TriggerId) {
+			add(AlertEventTriggered, instance)
+			continue
+		}
+		if !before.Acknowledged() && instance.Acknowledged() {
+			add(AlertEventAcknowledged, instance)
+		}
+		if !before.MuteInfo.Muted && instance.MuteInfo.Muted {
+			add(AlertEventMuted, instance)
+		}
+	}
+
+	return events
+}
+
+// equalPtr reports whether both pointers are nil or point to equal values.
+func equalPtr[T comparable](a *T, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
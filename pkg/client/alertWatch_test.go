@@ -0,0 +1,226 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock is a clock whose waits are released by the test.
+type fakeClock struct {
+	mu    sync.Mutex
+	now   time.Time
+	waits chan fakeWait
+}
+
+type fakeWait struct {
+	d time.Duration
+	c chan time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{
+		now:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		waits: make(chan fakeWait, 1),
+	}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	wait := fakeWait{d: d, c: make(chan time.Time, 1)}
+	c.waits <- wait
+	return wait.c
+}
+
+// advance waits for the next call to After, checks its duration and releases it.
+func (c *fakeClock) advance(t *testing.T, want time.Duration) {
+	t.Helper()
+	c.release(c.next(t, want))
+}
+
+// next waits for the next call to After and checks its duration.
+func (c *fakeClock) next(t *testing.T, want time.Duration) fakeWait {
+	t.Helper()
+
+	select {
+	case wait := <-c.waits:
+		if wait.d != want {
+			t.Errorf("fakeClock.After(%v), want %v", wait.d, want)
+		}
+		return wait
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for fakeClock.After")
+		return fakeWait{}
+	}
+}
+
+// release moves the clock forward by the duration of the wait and ends it.
+func (c *fakeClock) release(wait fakeWait) {
+	c.mu.Lock()
+	c.now = c.now.Add(wait.d)
+	now := c.now
+	c.mu.Unlock()
+	wait.c <- now
+}
+
+func watchInstanceJSON(definitionId string, entityId string, triggerId string, acknowledged bool, muted bool) string {
+	user := "null"
+	if acknowledged {
+		user = `{"id": "u-1"}`
+	}
+	return fmt.Sprintf(`{
+		"__typename": "AlertMetricConditionEvaluationFlat",
+		"alertDefinitionId": %q,
+		"target": {"entityId": %q},
+		"state": "FIRING",
+		"changedAt": "2024-01-01T00:00:00Z",
+		"muteInfo": {"muted": %t},
+		"triggerId": %q,
+		"acknowledgedByUser": %s
+	}`, definitionId, entityId, muted, triggerId, user)
+}
+
+func receiveAlertEvents(t *testing.T, events <-chan AlertEvent, count int) []AlertEvent {
+	t.Helper()
+
+	var got []AlertEvent
+	for len(got) < count {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				t.Fatalf("Swo.WatchAlerts channel closed after %d events, want %d", len(got), count)
+			}
+			got = append(got, event)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Swo.WatchAlerts timed out after %d events, want %d", len(got), count)
+		}
+	}
+	return got
+}
+
+func TestWatchAlerts(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	clock := newFakeClock()
+	client.clock = clock
+
+	var mu sync.Mutex
+	failing := false
+	instances := []string{watchInstanceJSON("a-1", "e-1", "t-1", false, false)}
+	setState := func(fail bool, state ...string) {
+		mu.Lock()
+		defer mu.Unlock()
+		failing = fail
+		instances = state
+	}
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if failing {
+			httpErrorResponse(w, r)
+			return
+		}
+		sendGraphQLResponse(t, w, []byte(`{"data": {"alertQueries": {"activeAlerts": {
+			"conditionEvaluations": [`+strings.Join(instances, ",")+`],
+			"pageInfo": {"hasNextPage": false}
+		}}}}`))
+	})
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	interval := time.Minute
+	events, err := client.AlertsService().Watch(ctx, ActiveAlertsFilterInput{}, interval)
+	if err != nil {
+		t.Fatalf("Swo.WatchAlerts error: %v", err)
+	}
+
+	// a-1 is acknowledged and a-2 is triggered.
+	setState(false,
+		watchInstanceJSON("a-1", "e-1", "t-1", true, false),
+		watchInstanceJSON("a-2", "e-2", "t-2", false, false))
+	clock.advance(t, interval)
+
+	got := receiveAlertEvents(t, events, 2)
+	if got[0].Type != AlertEventAcknowledged || got[0].Instance.AlertDefinitionId != "a-1" {
+		t.Errorf("Swo.WatchAlerts event 0 = %s %s, want ACKNOWLEDGED a-1", got[0].Type, got[0].Instance.AlertDefinitionId)
+	}
+	if got[1].Type != AlertEventTriggered || got[1].Instance.AlertDefinitionId != "a-2" {
+		t.Errorf("Swo.WatchAlerts event 1 = %s %s, want TRIGGERED a-2", got[1].Type, got[1].Instance.AlertDefinitionId)
+	}
+	if want := clock.Now(); !got[0].Time.Equal(want) {
+		t.Errorf("Swo.WatchAlerts event time = %v, want %v", got[0].Time, want)
+	}
+
+	// Failed polls back off until a poll succeeds.
+	setState(true)
+	clock.advance(t, interval)
+	clock.advance(t, 2*interval)
+	wait := clock.next(t, 4*interval)
+	setState(false, watchInstanceJSON("a-2", "e-2", "t-2", false, true))
+	clock.release(wait)
+
+	got = receiveAlertEvents(t, events, 2)
+	if got[0].Type != AlertEventResolved || got[0].Instance.AlertDefinitionId != "a-1" {
+		t.Errorf("Swo.WatchAlerts event 2 = %s %s, want RESOLVED a-1", got[0].Type, got[0].Instance.AlertDefinitionId)
+	}
+	if got[1].Type != AlertEventMuted || got[1].Instance.AlertDefinitionId != "a-2" {
+		t.Errorf("Swo.WatchAlerts event 3 = %s %s, want MUTED a-2", got[1].Type, got[1].Instance.AlertDefinitionId)
+	}
+
+	// An unchanged snapshot emits nothing and the interval is restored.
+	clock.advance(t, interval)
+	clock.advance(t, interval)
+
+	cancel()
+	select {
+	case event, ok := <-events:
+		if ok {
+			t.Errorf("Swo.WatchAlerts returned %+v after cancel, want closed channel", event)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Swo.WatchAlerts channel was not closed after cancel")
+	}
+}
+
+func TestWatchAlerts_Errors(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", httpErrorResponse)
+
+	if _, err := client.AlertsService().Watch(ctx, ActiveAlertsFilterInput{}, 0); err == nil {
+		t.Error("Swo.WatchAlerts expected an error for a zero interval")
+	}
+	if _, err := client.AlertsService().Watch(ctx, ActiveAlertsFilterInput{}, time.Minute); err == nil {
+		t.Error("Swo.WatchAlerts expected an error response")
+	}
+}
+
+func TestDiffAlertSnapshots_Retriggered(t *testing.T) {
+	before := AlertInstance{AlertDefinitionId: "a-1", TriggerId: Ptr("t-1"), MuteInfo: AlertInstanceMuteInfo{Muted: true}}
+	after := AlertInstance{AlertDefinitionId: "a-1", TriggerId: Ptr("t-2")}
+	now := time.Now()
+
+	got := diffAlertSnapshots(
+		map[string]AlertInstance{alertInstanceKey(before): before},
+		map[string]AlertInstance{alertInstanceKey(after): after},
+		now)
+
+	want := []AlertEvent{{Type: AlertEventTriggered, Instance: after, Time: now}}
+	if !testObjects(t, got, want) {
+		t.Errorf("diffAlertSnapshots returned %+v, want %+v", got, want)
+	}
+}
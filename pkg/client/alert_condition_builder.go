@@ -0,0 +1,455 @@
+package client
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// The name of the flat condition link that lists the operands of a node.
+const alertConditionOperandsLink = "operands"
+
+// AlertCondition is a node of an alert condition tree. Conditions are built from fields and
+// constants, e.g.
+//
+//	Metric("system.cpu").Avg().Over(5 * time.Minute).Gt(Const(90)).ForEntities("Host")
+//
+// and combined with And and Or. Build flattens the tree into the nodes of
+// AlertDefinitionInput.Condition. Methods return a modified copy and never change the receiver.
+type AlertCondition struct {
+	Type             AlertOperatorType
+	Operator         string
+	Operands         []AlertCondition
+	EntityFilter     *AlertConditionNodeEntityFilterInput
+	MetricFilter     *AlertFilterExpressionInput
+	FieldName        *string
+	DataType         *string
+	Value            *string
+	Values           []string
+	Query            *string
+	Namespace        *string
+	GroupByMetricTag []string
+}
+
+// Metric returns a condition on the entity metric with the given name.
+func Metric(fieldName string) AlertCondition {
+	return AlertCondition{Type: AlertMetricFieldType, FieldName: &fieldName}
+}
+
+// Attribute returns a condition on the entity attribute with the given name.
+func Attribute(fieldName string) AlertCondition {
+	return AlertCondition{Type: AlertAttributeType, FieldName: &fieldName}
+}
+
+// EventQuery returns a condition on the events or logs in the namespace matching the query.
+func EventQuery(namespace string, query string) AlertCondition {
+	return AlertCondition{Type: AlertQueryFieldType, Namespace: &namespace, Query: &query}
+}
+
+// Const returns a constant value. Numbers, strings and booleans are supported.
+func Const[T ~int | ~int64 | ~float64 | ~string | ~bool](value T) AlertCondition {
+	// The kind is used instead of the type, so that named types such as AlertSeverity are
+	// matched by their underlying type.
+	var dataType string
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.String:
+		dataType = "string"
+	case reflect.Bool:
+		dataType = "boolean"
+	default:
+		dataType = "number"
+	}
+
+	return AlertCondition{Type: AlertConstantValueType, DataType: &dataType, Value: Ptr(fmt.Sprint(value))}
+}
+
+// ConstList returns a list of constant string values for use with the In operator.
+func ConstList(values ...string) AlertCondition {
+	return AlertCondition{Type: AlertConstantValueType, DataType: Ptr("string"), Values: values}
+}
+
+// And returns a condition that holds when all of the given conditions hold.
+func And(conditions ...AlertCondition) AlertCondition {
+	return logicalCondition(AlertOperatorAnd, conditions)
+}
+
+// Or returns a condition that holds when any of the given conditions holds.
+func Or(conditions ...AlertCondition) AlertCondition {
+	return logicalCondition(AlertOperatorOr, conditions)
+}
+
+// Not returns a condition that holds when the given condition does not hold.
+func Not(condition AlertCondition) AlertCondition {
+	return AlertCondition{
+		Type:     AlertUnaryOperatorType,
+		Operator: string(AlertOperatorNot),
+		Operands: []AlertCondition{condition},
+	}
+}
+
+func logicalCondition(operator AlertLogicalOperator, conditions []AlertCondition) AlertCondition {
+	return AlertCondition{
+		Type:     AlertLogicalOperatorType,
+		Operator: string(operator),
+		Operands: slices.Clone(conditions),
+	}
+}
+
+func (c AlertCondition) aggregate(operator AlertAggregationOperator) AlertCondition {
+	return AlertCondition{Type: AlertAggregationOperatorType, Operator: string(operator), Operands: []AlertCondition{c}}
+}
+
+// Count aggregates the field by counting its values.
+func (c AlertCondition) Count() AlertCondition { return c.aggregate(AlertOperatorCount) }
+
+// Min aggregates the field to its minimum value.
+func (c AlertCondition) Min() AlertCondition { return c.aggregate(AlertOperatorMin) }
+
+// Max aggregates the field to its maximum value.
+func (c AlertCondition) Max() AlertCondition { return c.aggregate(AlertOperatorMax) }
+
+// Avg aggregates the field to its average value.
+func (c AlertCondition) Avg() AlertCondition { return c.aggregate(AlertOperatorAvg) }
+
+// Sum aggregates the field to the sum of its values.
+func (c AlertCondition) Sum() AlertCondition { return c.aggregate(AlertOperatorSum) }
+
+// Last aggregates the field to its most recent value.
+func (c AlertCondition) Last() AlertCondition { return c.aggregate(AlertOperatorLast) }
+
+// Over sets the time window of an aggregation, e.g. Metric("system.cpu").Avg().Over(time.Hour).
+func (c AlertCondition) Over(window time.Duration) AlertCondition {
+	c.Operands = append(slices.Clone(c.Operands), AlertCondition{
+		Type:     AlertConstantValueType,
+		DataType: Ptr("string"),
+		Value:    Ptr(formatAlertWindow(window)),
+	})
+	return c
+}
+
+func (c AlertCondition) compare(operator AlertBinaryOperator, other AlertCondition) AlertCondition {
+	return AlertCondition{Type: AlertBinaryOperatorType, Operator: string(operator), Operands: []AlertCondition{c, other}}
+}
+
+// Eq compares the condition to other with the = operator.
+func (c AlertCondition) Eq(other AlertCondition) AlertCondition {
+	return c.compare(AlertOperatorEq, other)
+}
+
+// Ne compares the condition to other with the != operator.
+func (c AlertCondition) Ne(other AlertCondition) AlertCondition {
+	return c.compare(AlertOperatorNe, other)
+}
+
+// Gt compares the condition to other with the > operator.
+func (c AlertCondition) Gt(other AlertCondition) AlertCondition {
+	return c.compare(AlertOperatorGt, other)
+}
+
+// Lt compares the condition to other with the < operator.
+func (c AlertCondition) Lt(other AlertCondition) AlertCondition {
+	return c.compare(AlertOperatorLt, other)
+}
+
+// Ge compares the condition to other with the >= operator.
+func (c AlertCondition) Ge(other AlertCondition) AlertCondition {
+	return c.compare(AlertOperatorGe, other)
+}
+
+// Le compares the condition to other with the <= operator.
+func (c AlertCondition) Le(other AlertCondition) AlertCondition {
+	return c.compare(AlertOperatorLe, other)
+}
+
+// In checks that the condition is one of the values of other, usually a ConstList.
+func (c AlertCondition) In(other AlertCondition) AlertCondition {
+	return c.compare(AlertOperatorIn, other)
+}
+
+// ForEntities scopes every field of the condition to entities of the given types.
+func (c AlertCondition) ForEntities(types ...string) AlertCondition {
+	return c.mapFields(func(field *AlertCondition) {
+		filter := AlertConditionNodeEntityFilterInput{}
+		if field.EntityFilter != nil {
+			filter = *field.EntityFilter
+		}
+		filter.Types = slices.Clone(types)
+		field.EntityFilter = &filter
+	})
+}
+
+// WithEntityFilter scopes every field of the condition to the entities matching the filter.
+func (c AlertCondition) WithEntityFilter(filter AlertConditionNodeEntityFilterInput) AlertCondition {
+	return c.mapFields(func(field *AlertCondition) {
+		field.EntityFilter = Ptr(filter)
+	})
+}
+
+// WithMetricFilter filters the measurements of every aggregation in the condition by their tags.
+func (c AlertCondition) WithMetricFilter(filter AlertFilterExpressionInput) AlertCondition {
+	return c.mapNodes(func(node *AlertCondition) {
+		if node.Type == AlertAggregationOperatorType {
+			node.MetricFilter = Ptr(filter)
+		}
+	})
+}
+
+// mapFields applies fn to a copy of every metric, query and scope field in the condition.
+func (c AlertCondition) mapFields(fn func(*AlertCondition)) AlertCondition {
+	return c.mapNodes(func(node *AlertCondition) {
+		switch node.Type {
+		case AlertMetricFieldType, AlertQueryFieldType, AlertScopeFieldType:
+			fn(node)
+		}
+	})
+}
+
+// mapNodes applies fn to a copy of every node in the condition.
+func (c AlertCondition) mapNodes(fn func(*AlertCondition)) AlertCondition {
+	fn(&c)
+
+	if len(c.Operands) > 0 {
+		operands := make([]AlertCondition, len(c.Operands))
+		for i, operand := range c.Operands {
+			operands[i] = operand.mapNodes(fn)
+		}
+		c.Operands = operands
+	}
+
+	return c
+}
+
+// Build validates the condition and flattens it into linked condition nodes. The nodes are
+// numbered depth first starting with the root at 0.
+func (c AlertCondition) Build() ([]AlertConditionNodeInput, error) {
+	var nodes []AlertConditionNodeInput
+	if _, err := c.flatten(&nodes); err != nil {
+		return nil, err
+	}
+	return nodes, nil
+}
+
+func (c AlertCondition) flatten(nodes *[]AlertConditionNodeInput) (int, error) {
+	if err := c.validate(); err != nil {
+		return 0, err
+	}
+
+	id := len(*nodes)
+	node := AlertConditionNodeInput{
+		Id:               id,
+		Type:             string(c.Type),
+		EntityFilter:     c.EntityFilter,
+		MetricFilter:     c.MetricFilter,
+		FieldName:        c.FieldName,
+		DataType:         c.DataType,
+		Value:            c.Value,
+		Values:           c.Values,
+		Query:            c.Query,
+		Namespace:        c.Namespace,
+		GroupByMetricTag: c.GroupByMetricTag,
+	}
+	if c.Operator != "" {
+		node.Operator = Ptr(c.Operator)
+	}
+	*nodes = append(*nodes, node)
+
+	var operandIds []int
+	for _, operand := range c.Operands {
+		operandId, err := operand.flatten(nodes)
+		if err != nil {
+			return 0, err
+		}
+		operandIds = append(operandIds, operandId)
+	}
+	(*nodes)[id].OperandIds = operandIds
+
+	return id, nil
+}
+
+// validate checks the operator and number of operands of the node against the condition schema.
+func (c AlertCondition) validate() error {
+	operators, hasOperators := AlertOperators[c.Type]
+	if hasOperators && !sliceValueExists(operators, c.Operator) {
+		return fmt.Errorf("alert condition %s operator %q is not supported", c.Type, c.Operator)
+	}
+
+	operandTypes := make([]AlertOperatorType, len(c.Operands))
+	for i, operand := range c.Operands {
+		operandTypes[i] = operand.Type
+	}
+	errs, supported := validateAlertOperands(c.Type, operandTypes)
+	if !supported {
+		return fmt.Errorf("alert condition type %q is not supported", c.Type)
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("alert condition %s", errs[0].Message)
+	}
+
+	return nil
+}
+
+// Condition returns the condition of the alert definition in builder form.
+func (r *ReadAlertDefinitionResult) Condition() (AlertCondition, error) {
+	return AlertConditionFromFlat(r.FlatCondition)
+}
+
+// AlertConditionFromFlat rebuilds a condition tree from the flat condition of an alert
+// definition. The first node is the root of the tree.
+func AlertConditionFromFlat(flat []ReadAlertConditionResult) (AlertCondition, error) {
+	if len(flat) == 0 {
+		return AlertCondition{}, fmt.Errorf("alert condition is empty")
+	}
+
+	nodes := make(map[string]ReadAlertConditionResult, len(flat))
+	for _, node := range flat {
+		nodes[node.Id] = node
+	}
+
+	return alertConditionFromNode(nodes, flat[0], map[string]bool{})
+}
+
+func alertConditionFromNode(nodes map[string]ReadAlertConditionResult, node ReadAlertConditionResult, visited map[string]bool) (AlertCondition, error) {
+	if visited[node.Id] {
+		return AlertCondition{}, fmt.Errorf("alert condition node %s is linked more than once", node.Id)
+	}
+	visited[node.Id] = true
+
+	if node.Value == nil {
+		return AlertCondition{}, fmt.Errorf("alert condition node %s has no value", node.Id)
+	}
+
+	value := node.Value
+	condition := AlertCondition{
+		Type:             AlertOperatorType(value.Type),
+		EntityFilter:     alertEntityFilterFromRead(value.EntityFilter),
+		FieldName:        value.FieldName,
+		DataType:         value.DataType,
+		Value:            value.Value,
+		Values:           value.Values,
+		Query:            value.Query,
+		Namespace:        value.Namespace,
+		GroupByMetricTag: value.GroupByMetricTag,
+	}
+	if value.Operator != nil {
+		condition.Operator = *value.Operator
+	}
+
+	metricFilter, err := alertMetricFilterFromRead(value.MetricFilter)
+	if err != nil {
+		return AlertCondition{}, err
+	}
+	condition.MetricFilter = metricFilter
+
+	for _, link := range node.Links {
+		if link.Name != alertConditionOperandsLink {
+			continue
+		}
+
+		for _, id := range link.Values {
+			child, ok := nodes[id]
+			if !ok {
+				return AlertCondition{}, fmt.Errorf("alert condition node %s links to missing node %s", node.Id, id)
+			}
+
+			operand, err := alertConditionFromNode(nodes, child, visited)
+			if err != nil {
+				return AlertCondition{}, err
+			}
+			condition.Operands = append(condition.Operands, operand)
+		}
+	}
+
+	return condition, nil
+}
+
+func alertEntityFilterFromRead(filter *ReadAlertConditionEntityFilterResult) *AlertConditionNodeEntityFilterInput {
+	if filter == nil {
+		return nil
+	}
+
+	result := &AlertConditionNodeEntityFilterInput{
+		Types: filter.Types,
+		Ids:   filter.Ids,
+		Query: filter.Query,
+	}
+	for _, field := range filter.Fields {
+		rules := make([]AlertConditionMatchRuleInput, 0, len(field.Rules))
+		for _, rule := range field.Rules {
+			rules = append(rules, AlertConditionMatchRuleInput{Type: rule.Type, Negate: rule.Negate, Value: rule.Value})
+		}
+		result.Fields = append(result.Fields, AlertConditionMatchFieldRuleInput{FieldName: field.FieldName, Rules: rules})
+	}
+
+	return result
+}
+
+// alertMetricFilterFromRead rebuilds a metric filter expression from its flat form. The first
+// expression is the root, and any linked expressions are its children.
+func alertMetricFilterFromRead(flat []*ReadAlertMetricFilterResult) (*AlertFilterExpressionInput, error) {
+	if len(flat) == 0 || flat[0] == nil {
+		return nil, nil
+	}
+
+	expressions := make(map[string]*ReadAlertMetricFilterResult, len(flat))
+	for _, expression := range flat {
+		if expression != nil {
+			expressions[expression.Id] = expression
+		}
+	}
+
+	var build func(expression *ReadAlertMetricFilterResult, depth int) (AlertFilterExpressionInput, error)
+	build = func(expression *ReadAlertMetricFilterResult, depth int) (AlertFilterExpressionInput, error) {
+		if depth > len(flat) {
+			return AlertFilterExpressionInput{}, fmt.Errorf("alert metric filter %s contains a cycle", expression.Id)
+		}
+
+		var result AlertFilterExpressionInput
+		if expression.Value != nil {
+			result = AlertFilterExpressionInput{
+				PropertyName:   expression.Value.PropertyName,
+				PropertyValue:  expression.Value.PropertyValue,
+				PropertyValues: expression.Value.PropertyValues,
+				Operation:      expression.Value.Operation,
+			}
+		}
+
+		for _, link := range expression.Links {
+			for _, id := range link.Values {
+				child, ok := expressions[id]
+				if !ok {
+					return AlertFilterExpressionInput{}, fmt.Errorf("alert metric filter %s links to missing expression %s", expression.Id, id)
+				}
+
+				childResult, err := build(child, depth+1)
+				if err != nil {
+					return AlertFilterExpressionInput{}, err
+				}
+				result.Children = append(result.Children, childResult)
+			}
+		}
+
+		return result, nil
+	}
+
+	result, err := build(flat[0], 0)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// formatAlertWindow formats the duration in the largest whole unit, e.g. 1d, 5m or 30s.
+func formatAlertWindow(window time.Duration) string {
+	switch {
+	case window >= 24*time.Hour && window%(24*time.Hour) == 0:
+		return strconv.FormatInt(int64(window/(24*time.Hour)), 10) + "d"
+	case window >= time.Hour && window%time.Hour == 0:
+		return strconv.FormatInt(int64(window/time.Hour), 10) + "h"
+	case window >= time.Minute && window%time.Minute == 0:
+		return strconv.FormatInt(int64(window/time.Minute), 10) + "m"
+	default:
+		return strconv.FormatInt(int64(window/time.Second), 10) + "s"
+	}
+}
@@ -0,0 +1,217 @@
+package client
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// flatAlertCondition converts built condition nodes to the flat form returned when reading an
+// alert definition.
+func flatAlertCondition(t *testing.T, nodes []AlertConditionNodeInput) []ReadAlertConditionResult {
+	var result []ReadAlertConditionResult
+	for _, node := range nodes {
+		var operands []string
+		for _, id := range node.OperandIds {
+			operands = append(operands, "node-"+strconv.Itoa(id))
+		}
+
+		value := &ReadAlertConditionValueResult{
+			Type:             node.Type,
+			Operator:         node.Operator,
+			FieldName:        node.FieldName,
+			DataType:         node.DataType,
+			Value:            node.Value,
+			Values:           node.Values,
+			Query:            node.Query,
+			Namespace:        node.Namespace,
+			GroupByMetricTag: node.GroupByMetricTag,
+		}
+		if node.EntityFilter != nil {
+			value.EntityFilter = &ReadAlertConditionEntityFilterResult{Types: node.EntityFilter.Types}
+		}
+		if node.MetricFilter != nil {
+			if len(node.MetricFilter.Children) > 0 {
+				t.Fatal("flatAlertCondition only supports metric filters without children")
+			}
+			value.MetricFilter = []*ReadAlertMetricFilterResult{{
+				Id: "filter-" + strconv.Itoa(node.Id),
+				Value: &ReadAlertMetricFilterValueResult{
+					Operation:     node.MetricFilter.Operation,
+					PropertyName:  node.MetricFilter.PropertyName,
+					PropertyValue: node.MetricFilter.PropertyValue,
+				},
+			}}
+		}
+
+		result = append(result, ReadAlertConditionResult{
+			Id:    "node-" + strconv.Itoa(node.Id),
+			Links: []ReadAlertConditionLinkResult{{Name: "operands", Values: operands}},
+			Value: value,
+		})
+	}
+	return result
+}
+
+func TestAlertCondition_Build(t *testing.T) {
+	got, err := Metric("Orion.NPM.InterfaceTraffic.InTotalBytes").
+		Max().
+		Over(24 * time.Hour).
+		WithMetricFilter(AlertFilterExpressionInput{Operation: FilterOperationEq}).
+		Gt(Const(90)).
+		ForEntities("DeviceVolume").
+		Build()
+	if err != nil {
+		t.Fatalf("AlertCondition.Build returned error: %v", err)
+	}
+
+	want := mockAlertDefinitionInput(mockAlertName, mockAlertDescription).Condition
+
+	if !testObjects(t, got, want) {
+		t.Errorf("AlertCondition.Build returned %+v, want %+v", got, want)
+	}
+}
+
+func TestAlertCondition_BuildLogical(t *testing.T) {
+	cpu := Metric("system.cpu").Avg().Gt(Const(90.5))
+	status := Attribute("status").In(ConstList("down", "unknown"))
+
+	got, err := Or(And(cpu, status), Attribute("enabled").Eq(Const(false))).ForEntities("Host").Build()
+	if err != nil {
+		t.Fatalf("AlertCondition.Build returned error: %v", err)
+	}
+
+	want := []AlertConditionNodeInput{
+		{Id: 0, Type: "logicalOperator", Operator: Ptr("OR"), OperandIds: []int{1, 9}},
+		{Id: 1, Type: "logicalOperator", Operator: Ptr("AND"), OperandIds: []int{2, 6}},
+		{Id: 2, Type: "binaryOperator", Operator: Ptr(">"), OperandIds: []int{3, 5}},
+		{Id: 3, Type: "aggregationOperator", Operator: Ptr("AVG"), OperandIds: []int{4}},
+		{Id: 4, Type: "metricField", FieldName: Ptr("system.cpu"), EntityFilter: &AlertConditionNodeEntityFilterInput{Types: []string{"Host"}}},
+		{Id: 5, Type: "constantValue", DataType: Ptr("number"), Value: Ptr("90.5")},
+		{Id: 6, Type: "binaryOperator", Operator: Ptr("IN"), OperandIds: []int{7, 8}},
+		{Id: 7, Type: "attributeField", FieldName: Ptr("status")},
+		{Id: 8, Type: "constantValue", DataType: Ptr("string"), Values: []string{"down", "unknown"}},
+		{Id: 9, Type: "binaryOperator", Operator: Ptr("="), OperandIds: []int{10, 11}},
+		{Id: 10, Type: "attributeField", FieldName: Ptr("enabled")},
+		{Id: 11, Type: "constantValue", DataType: Ptr("boolean"), Value: Ptr("false")},
+	}
+
+	if !testObjects(t, got, want) {
+		t.Errorf("AlertCondition.Build returned %+v, want %+v", got, want)
+	}
+}
+
+func TestConst_DataType(t *testing.T) {
+	type enabled bool
+
+	tests := []struct {
+		condition AlertCondition
+		want      string
+	}{
+		{Const(90), "number"},
+		{Const(0.5), "number"},
+		{Const("up"), "string"},
+		{Const(AlertSeverityCritical), "string"},
+		{Const(true), "boolean"},
+		{Const(enabled(false)), "boolean"},
+	}
+
+	for _, tt := range tests {
+		if got := *tt.condition.DataType; got != tt.want {
+			t.Errorf("Const(%s) data type = %s, want %s", *tt.condition.Value, got, tt.want)
+		}
+	}
+}
+
+func TestAlertCondition_BuildInvalid(t *testing.T) {
+	cpu := Metric("system.cpu").Avg().Gt(Const(90))
+
+	tests := []struct {
+		name      string
+		condition AlertCondition
+		wantErr   string
+	}{
+		{"single logical operand", And(cpu), "requires at least 2 operands"},
+		{"missing binary operand", AlertCondition{Type: AlertBinaryOperatorType, Operator: ">", Operands: []AlertCondition{Const(1)}}, "requires 2 operands"},
+		{"unsupported operator", AlertCondition{Type: AlertBinaryOperatorType, Operator: "~", Operands: []AlertCondition{Const(1), Const(2)}}, `operator "~" is not supported`},
+		{"aggregate constant", Const(1).Avg().Gt(Const(2)), "aggregationOperator requires a metricField or queryField operand, got constantValue"},
+		{"window on field", Metric("system.cpu").Over(time.Minute).Gt(Const(2)), "takes no operands"},
+		{"unsupported type", AlertCondition{Type: "unknown"}, `type "unknown" is not supported`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.condition.Build()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("AlertCondition.Build error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAlertCondition_Immutable(t *testing.T) {
+	cpu := Metric("system.cpu").Avg().Gt(Const(90))
+	_ = cpu.ForEntities("Host")
+
+	if cpu.Operands[0].Operands[0].EntityFilter != nil {
+		t.Error("AlertCondition.ForEntities changed the receiver")
+	}
+}
+
+func TestAlertConditionFromFlat(t *testing.T) {
+	want := Or(
+		Metric("system.cpu").Max().Over(5*time.Minute).
+			WithMetricFilter(AlertFilterExpressionInput{PropertyName: Ptr("cpu"), PropertyValue: Ptr("0"), Operation: FilterOperationEq}).
+			Ge(Const(90)),
+		EventQuery("logs", "level:error").Count().Over(time.Hour).Gt(Const(10)),
+	).ForEntities("Host")
+
+	nodes, err := want.Build()
+	if err != nil {
+		t.Fatalf("AlertCondition.Build returned error: %v", err)
+	}
+
+	alert := ReadAlertDefinitionResult{FlatCondition: flatAlertCondition(t, nodes)}
+	got, err := alert.Condition()
+	if err != nil {
+		t.Fatalf("ReadAlertDefinitionResult.Condition returned error: %v", err)
+	}
+
+	if !testObjects(t, got, want) {
+		t.Errorf("ReadAlertDefinitionResult.Condition returned %+v, want %+v", got, want)
+	}
+
+	// The rebuilt condition flattens to the same nodes.
+	rebuilt, err := got.Build()
+	if err != nil {
+		t.Fatalf("AlertCondition.Build returned error: %v", err)
+	}
+	if !testObjects(t, rebuilt, nodes) {
+		t.Errorf("AlertCondition.Build returned %+v, want %+v", rebuilt, nodes)
+	}
+}
+
+func TestAlertConditionFromFlat_Invalid(t *testing.T) {
+	if _, err := AlertConditionFromFlat(nil); err == nil {
+		t.Error("AlertConditionFromFlat expected an error for an empty condition")
+	}
+
+	missing := []ReadAlertConditionResult{{
+		Id:    "root",
+		Links: []ReadAlertConditionLinkResult{{Name: "operands", Values: []string{"missing"}}},
+		Value: &ReadAlertConditionValueResult{Type: "logicalOperator", Operator: Ptr("AND")},
+	}}
+	if _, err := AlertConditionFromFlat(missing); err == nil {
+		t.Error("AlertConditionFromFlat expected an error for a missing operand")
+	}
+
+	cycle := []ReadAlertConditionResult{{
+		Id:    "root",
+		Links: []ReadAlertConditionLinkResult{{Name: "operands", Values: []string{"root"}}},
+		Value: &ReadAlertConditionValueResult{Type: "logicalOperator", Operator: Ptr("AND")},
+	}}
+	if _, err := AlertConditionFromFlat(cycle); err == nil {
+		t.Error("AlertConditionFromFlat expected an error for a cycle")
+	}
+}
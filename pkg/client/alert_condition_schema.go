@@ -15,6 +15,7 @@ const (
 	AlertRelationshipOperatorType            AlertOperatorType = "relationshipOperator"
 	AlertRelationshipAggregationOperatorType AlertOperatorType = "relationshipAggregationOperator"
 	AlertScopeFieldType                      AlertOperatorType = "scopeField"
+	AlertUnaryOperatorType                   AlertOperatorType = "unaryOperator"
 )
 
 type AlertAggregationOperator string
@@ -40,6 +41,12 @@ const (
 	AlertOperatorIn AlertBinaryOperator = "IN"
 )
 
+type AlertUnaryOperator string
+
+const (
+	AlertOperatorNot AlertUnaryOperator = "!"
+)
+
 type AlertLogicalOperator string
 
 const (
@@ -70,6 +77,9 @@ var (
 			string(AlertOperatorAnd),
 			string(AlertOperatorOr),
 		},
+		AlertUnaryOperatorType: {
+			string(AlertOperatorNot),
+		},
 	}
 
 	FilterOperations = map[string]FilterOperation{
@@ -0,0 +1,257 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ValidationError describes a problem with one field of an input. Path is the JSON path of the
+// field, e.g. $.condition[2].operandIds[0].
+type ValidationError struct {
+	Path    string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	return e.Path + ": " + e.Message
+}
+
+// joinValidationErrors joins the errors into one error that wraps ErrValidation and each of
+// the errors. It returns nil if there are no errors.
+func joinValidationErrors(errs []ValidationError) error {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	joined := make([]error, len(errs))
+	for i, err := range errs {
+		joined[i] = err
+	}
+	return fmt.Errorf("%w: %w", ErrValidation, errors.Join(joined...))
+}
+
+// The data types supported by constantValue condition nodes.
+var alertConstantDataTypes = []string{"boolean", "number", "string"}
+
+// ValidateAlertDefinition checks the alert definition for errors that would otherwise only be
+// reported by the server. It checks the severity and that the condition nodes form a single tree
+// rooted at the first node, with id 0, whose operators and operands match the condition schema. It returns nil when no
+// errors are found.
+func ValidateAlertDefinition(input AlertDefinitionInput) []ValidationError {
+	var errs []ValidationError
+	addError := func(path string, format string, args ...any) {
+		errs = append(errs, ValidationError{Path: path, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if input.Name == "" {
+		addError("$.name", "is required")
+	}
+	if !slices.Contains(AllAlertSeverity, input.Severity) {
+		addError("$.severity", "%q is not one of %v", input.Severity, AllAlertSeverity)
+	}
+
+	nodes := input.Condition
+	if len(nodes) == 0 {
+		addError("$.condition", "must contain at least one node")
+		return errs
+	}
+
+	// Index the nodes by id and count how often each node is used as an operand.
+	indexes := make(map[int]int, len(nodes))
+	for i, node := range nodes {
+		if _, ok := indexes[node.Id]; ok {
+			addError(nodePath(i, "id"), "duplicate node id %d", node.Id)
+			continue
+		}
+		indexes[node.Id] = i
+	}
+
+	references := make(map[int]int, len(nodes))
+	for i, node := range nodes {
+		for j, id := range node.OperandIds {
+			if _, ok := indexes[id]; !ok {
+				addError(nodePath(i, fmt.Sprintf("operandIds[%d]", j)), "references missing node %d", id)
+				continue
+			}
+			references[id]++
+			if references[id] == 2 {
+				addError(nodePath(i, fmt.Sprintf("operandIds[%d]", j)), "node %d is already an operand of another node", id)
+			}
+		}
+	}
+
+	for i, node := range nodes {
+		errs = append(errs, validateAlertConditionNode(i, node, nodes, indexes)...)
+	}
+
+	// The server takes the first node as the root of the tree.
+	if nodes[0].Id != 0 {
+		addError(nodePath(0, "id"), "must be 0, the first node is the root node, got %d", nodes[0].Id)
+	}
+
+	root, ok := indexes[0]
+	if !ok {
+		addError("$.condition", "has no root node with id 0")
+		return errs
+	}
+	if references[0] > 0 {
+		addError(nodePath(root, "id"), "root node 0 must not be an operand of another node")
+	}
+
+	// Walk the tree from the root to find cycles and nodes that are not linked to it.
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make([]int, len(nodes))
+	var walk func(i int)
+	walk = func(i int) {
+		state[i] = visiting
+		for j, id := range nodes[i].OperandIds {
+			child, ok := indexes[id]
+			if !ok {
+				continue
+			}
+			switch state[child] {
+			case visiting:
+				addError(nodePath(i, fmt.Sprintf("operandIds[%d]", j)), "creates a cycle through node %d", id)
+			case unvisited:
+				walk(child)
+			}
+		}
+		state[i] = visited
+	}
+	walk(root)
+
+	for i, node := range nodes {
+		if state[i] == unvisited && indexes[node.Id] == i {
+			addError(nodePath(i, ""), "node %d is not linked to the root node 0", node.Id)
+		}
+	}
+
+	return errs
+}
+
+// validateAlertConditionNode checks the type, operator, operands and values of a single node.
+func validateAlertConditionNode(i int, node AlertConditionNodeInput, nodes []AlertConditionNodeInput, indexes map[int]int) []ValidationError {
+	var errs []ValidationError
+	addError := func(field string, format string, args ...any) {
+		errs = append(errs, ValidationError{Path: nodePath(i, field), Message: fmt.Sprintf(format, args...)})
+	}
+
+	nodeType := AlertOperatorType(node.Type)
+
+	// Operators are checked against the operators supported by each node type.
+	_, hasOperators := AlertOperators[nodeType]
+	if hasOperators {
+		if node.Operator == nil {
+			addError("operator", "is required for %s", nodeType)
+		} else if operatorType, err := GetAlertConditionType(*node.Operator); err != nil {
+			addError("operator", "%q is not supported", *node.Operator)
+		} else if AlertOperatorType(operatorType) != nodeType {
+			addError("operator", "%q is a %s and is not supported by %s", *node.Operator, operatorType, nodeType)
+		}
+	}
+
+	operandTypes := make([]AlertOperatorType, len(node.OperandIds))
+	for j, id := range node.OperandIds {
+		if index, ok := indexes[id]; ok {
+			operandTypes[j] = AlertOperatorType(nodes[index].Type)
+		}
+	}
+	operandErrs, supported := validateAlertOperands(nodeType, operandTypes)
+	for _, err := range operandErrs {
+		addError(err.Path, "%s", err.Message)
+	}
+	if !supported {
+		addError("type", "%q is not supported", node.Type)
+	} else if !hasOperators && node.Operator != nil {
+		addError("operator", "must not be set for %s", nodeType)
+	}
+
+	// Leaves must carry the value they stand for.
+	switch nodeType {
+	case AlertMetricFieldType, AlertAttributeType:
+		if node.FieldName == nil || *node.FieldName == "" {
+			addError("fieldName", "is required for %s", nodeType)
+		}
+	case AlertQueryFieldType:
+		if node.Query == nil || *node.Query == "" {
+			addError("query", "is required for %s", nodeType)
+		}
+	case AlertConstantValueType:
+		if node.DataType == nil {
+			addError("dataType", "is required for %s", nodeType)
+		} else if !sliceValueExists(alertConstantDataTypes, *node.DataType) {
+			addError("dataType", "%q is not one of %v", *node.DataType, alertConstantDataTypes)
+		}
+		if node.Value == nil && len(node.Values) == 0 {
+			addError("value", "is required for %s", nodeType)
+		}
+	}
+
+	return errs
+}
+
+// validateAlertOperands checks the number and types of the operands of a condition node of the
+// given type against the condition schema. The paths of the returned errors are relative to the
+// node, e.g. operandIds[0]. Empty operand types, e.g. of operands that reference missing nodes,
+// are not checked. The second result is false if the node type is not supported.
+func validateAlertOperands(nodeType AlertOperatorType, operandTypes []AlertOperatorType) ([]ValidationError, bool) {
+	var errs []ValidationError
+	addError := func(path string, format string, args ...any) {
+		errs = append(errs, ValidationError{Path: path, Message: fmt.Sprintf(format, args...)})
+	}
+
+	operands := len(operandTypes)
+	switch nodeType {
+	case AlertBinaryOperatorType:
+		if operands != 2 {
+			addError("operandIds", "%s requires 2 operands, got %d", nodeType, operands)
+		}
+	case AlertLogicalOperatorType:
+		if operands < 2 {
+			addError("operandIds", "%s requires at least 2 operands, got %d", nodeType, operands)
+		}
+	case AlertUnaryOperatorType:
+		if operands != 1 {
+			addError("operandIds", "%s requires 1 operand, got %d", nodeType, operands)
+		}
+	case AlertAggregationOperatorType:
+		if operands < 1 || operands > 2 {
+			addError("operandIds", "%s requires a field and an optional window, got %d operands", nodeType, operands)
+			break
+		}
+		if field := operandTypes[0]; field != "" && field != AlertMetricFieldType && field != AlertQueryFieldType {
+			addError("operandIds[0]", "%s requires a %s or %s operand, got %s", nodeType, AlertMetricFieldType, AlertQueryFieldType, field)
+		}
+		if operands == 2 {
+			if window := operandTypes[1]; window != "" && window != AlertConstantValueType {
+				addError("operandIds[1]", "%s window must be a %s, got %s", nodeType, AlertConstantValueType, window)
+			}
+		}
+	case AlertRelationshipOperatorType, AlertRelationshipAggregationOperatorType:
+		if operands == 0 {
+			addError("operandIds", "%s requires at least 1 operand", nodeType)
+		}
+	case AlertConstantValueType, AlertAttributeType, AlertMetricFieldType, AlertQueryFieldType, AlertScopeFieldType:
+		if operands != 0 {
+			addError("operandIds", "%s takes no operands, got %d", nodeType, operands)
+		}
+	default:
+		return nil, false
+	}
+
+	return errs, true
+}
+
+// nodePath returns the JSON path of a field of the condition node at index i.
+func nodePath(i int, field string) string {
+	path := fmt.Sprintf("$.condition[%d]", i)
+	if field != "" {
+		path += "." + field
+	}
+	return path
+}
@@ -0,0 +1,156 @@
+package client
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateAlertDefinition_Valid(t *testing.T) {
+	input := mockAlertDefinitionInput(mockAlertName, mockAlertDescription)
+
+	if errs := ValidateAlertDefinition(input); errs != nil {
+		t.Errorf("ValidateAlertDefinition returned %v, want no errors", errs)
+	}
+
+	condition, err := Or(
+		Metric("system.cpu").Avg().Gt(Const(90)),
+		Not(Attribute("status").In(ConstList("up"))),
+	).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input.Condition = condition
+	if errs := ValidateAlertDefinition(input); errs != nil {
+		t.Errorf("ValidateAlertDefinition returned %v, want no errors", errs)
+	}
+
+	// Two metrics can be compared with each other, without a constant value.
+	condition, err = Metric("system.disk.used").Sum().Gt(Metric("system.disk.total").Sum()).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input.Condition = condition
+	if errs := ValidateAlertDefinition(input); errs != nil {
+		t.Errorf("ValidateAlertDefinition returned %v, want no errors", errs)
+	}
+}
+
+func TestValidateAlertDefinition_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(input *AlertDefinitionInput)
+		want   ValidationError
+	}{
+		{
+			name:   "severity",
+			modify: func(input *AlertDefinitionInput) { input.Severity = "SEVERE" },
+			want:   ValidationError{Path: "$.severity", Message: `"SEVERE" is not one of`},
+		},
+		{
+			name:   "empty condition",
+			modify: func(input *AlertDefinitionInput) { input.Condition = nil },
+			want:   ValidationError{Path: "$.condition", Message: "must contain at least one node"},
+		},
+		{
+			name:   "dangling operand",
+			modify: func(input *AlertDefinitionInput) { input.Condition[0].OperandIds = []int{1, 7} },
+			want:   ValidationError{Path: "$.condition[0].operandIds[1]", Message: "references missing node 7"},
+		},
+		{
+			name:   "cycle",
+			modify: func(input *AlertDefinitionInput) { input.Condition[1].OperandIds = []int{2, 1} },
+			want:   ValidationError{Path: "$.condition[1].operandIds[1]", Message: "creates a cycle through node 1"},
+		},
+		{
+			name: "root is not id 0",
+			modify: func(input *AlertDefinitionInput) {
+				for i := range input.Condition {
+					input.Condition[i].Id += 10
+					for j := range input.Condition[i].OperandIds {
+						input.Condition[i].OperandIds[j] += 10
+					}
+				}
+			},
+			want: ValidationError{Path: "$.condition", Message: "has no root node with id 0"},
+		},
+		{
+			name: "unlinked node",
+			modify: func(input *AlertDefinitionInput) {
+				input.Condition = append(input.Condition, AlertConditionNodeInput{Id: 5, Type: "constantValue", DataType: Ptr("number"), Value: Ptr("1")})
+			},
+			want: ValidationError{Path: "$.condition[5]", Message: "node 5 is not linked to the root node 0"},
+		},
+		{
+			name: "root node not first",
+			modify: func(input *AlertDefinitionInput) {
+				input.Condition[0], input.Condition[1] = input.Condition[1], input.Condition[0]
+			},
+			want: ValidationError{Path: "$.condition[0].id", Message: "must be 0, the first node is the root node, got 1"},
+		},
+		{
+			name:   "duplicate id",
+			modify: func(input *AlertDefinitionInput) { input.Condition[4].Id = 3 },
+			want:   ValidationError{Path: "$.condition[4].id", Message: "duplicate node id 3"},
+		},
+		{
+			name:   "operator of another type",
+			modify: func(input *AlertDefinitionInput) { input.Condition[0].Operator = Ptr("AND") },
+			want:   ValidationError{Path: "$.condition[0].operator", Message: `"AND" is a logicalOperator and is not supported by binaryOperator`},
+		},
+		{
+			name:   "unsupported operator",
+			modify: func(input *AlertDefinitionInput) { input.Condition[1].Operator = Ptr("MEDIAN") },
+			want:   ValidationError{Path: "$.condition[1].operator", Message: `"MEDIAN" is not supported`},
+		},
+		{
+			name:   "missing metric field",
+			modify: func(input *AlertDefinitionInput) { input.Condition[2].Type = "constantValue" },
+			want:   ValidationError{Path: "$.condition[1].operandIds[0]", Message: "aggregationOperator requires a metricField or queryField operand, got constantValue"},
+		},
+		{
+			name:   "missing binary operand",
+			modify: func(input *AlertDefinitionInput) { input.Condition[0].OperandIds = []int{1} },
+			want:   ValidationError{Path: "$.condition[0].operandIds", Message: "binaryOperator requires 2 operands, got 1"},
+		},
+		{
+			name:   "missing field name",
+			modify: func(input *AlertDefinitionInput) { input.Condition[2].FieldName = nil },
+			want:   ValidationError{Path: "$.condition[2].fieldName", Message: "is required for metricField"},
+		},
+		{
+			name:   "missing value",
+			modify: func(input *AlertDefinitionInput) { input.Condition[4].Value = nil },
+			want:   ValidationError{Path: "$.condition[4].value", Message: "is required for constantValue"},
+		},
+		{
+			name:   "unsupported type",
+			modify: func(input *AlertDefinitionInput) { input.Condition[4].Type = "randomValue" },
+			want:   ValidationError{Path: "$.condition[4].type", Message: `"randomValue" is not supported`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := mockAlertDefinitionInput(mockAlertName, mockAlertDescription)
+			tt.modify(&input)
+
+			errs := ValidateAlertDefinition(input)
+			for _, err := range errs {
+				if err.Path == tt.want.Path && strings.HasPrefix(err.Message, tt.want.Message) {
+					return
+				}
+			}
+			t.Errorf("ValidateAlertDefinition returned %v, want %v", errs, tt.want)
+		})
+	}
+}
+
+func TestValidationError_Error(t *testing.T) {
+	err := ValidationError{Path: "$.severity", Message: "is required"}
+
+	if got, want := err.Error(), "$.severity: is required"; got != want {
+		t.Errorf("ValidationError.Error() = %q, want %q", got, want)
+	}
+}
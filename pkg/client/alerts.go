@@ -2,7 +2,10 @@ package client
 
 import (
 	"context"
-	"log"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
 )
 
 type AlertsService service
@@ -14,28 +17,116 @@ type CreateAlertDefinitionResult = createAlertDefinitionMutationAlertMutationsCr
 type UpdateAlertDefinitionResult = updateAlertDefinitionMutationAlertMutationsUpdateAlertDefinition
 
 // Exported Read types
-type ReadAlertDefinitionResult = getAlertDefinitionByIdAlertQueriesAlertDefinitionsAlertDefinitionsResultAlertDefinitionsAlertDefinition
-type ReadAlertConditionResult = getAlertDefinitionByIdAlertQueriesAlertDefinitionsAlertDefinitionsResultAlertDefinitionsAlertDefinitionFlatConditionFlatAlertConditionExpression
-type ReadAlertActionResult = getAlertDefinitionByIdAlertQueriesAlertDefinitionsAlertDefinitionsResultAlertDefinitionsAlertDefinitionActionsAlertAction
-type ReadAlertMuteInfoResult = getAlertDefinitionByIdAlertQueriesAlertDefinitionsAlertDefinitionsResultAlertDefinitionsAlertDefinitionMuteInfo
-type ReadAlertUserResult = getAlertDefinitionByIdAlertQueriesAlertDefinitionsAlertDefinitionsResultAlertDefinitionsAlertDefinitionUser
-type ReadAlertConditionLinkResult = getAlertDefinitionByIdAlertQueriesAlertDefinitionsAlertDefinitionsResultAlertDefinitionsAlertDefinitionFlatConditionFlatAlertConditionExpressionLinksNamedLinks
-type ReadAlertConditionValueResult = getAlertDefinitionByIdAlertQueriesAlertDefinitionsAlertDefinitionsResultAlertDefinitionsAlertDefinitionFlatConditionFlatAlertConditionExpressionValueFlatAlertConditionNode
+type ReadAlertDefinitionResult = alertDefinitionFields
+type ReadAlertConditionResult = alertDefinitionFieldsFlatConditionFlatAlertConditionExpression
+type ReadAlertActionResult = alertDefinitionFieldsActionsAlertAction
+type ReadAlertMuteInfoResult = alertDefinitionFieldsMuteInfoAlertDefinitionMuteInfo
+type ReadAlertUserResult = alertDefinitionFieldsUser
+type ReadAlertConditionLinkResult = alertDefinitionFieldsFlatConditionFlatAlertConditionExpressionLinksNamedLinks
+type ReadAlertConditionValueResult = alertDefinitionFieldsFlatConditionFlatAlertConditionExpressionValueFlatAlertConditionNode
+type ReadAlertConditionEntityFilterResult = alertDefinitionFieldsFlatConditionFlatAlertConditionExpressionValueFlatAlertConditionNodeEntityFilter
+type ReadAlertMetricFilterResult = alertDefinitionFieldsFlatConditionFlatAlertConditionExpressionValueFlatAlertConditionNodeMetricFilterFlatAlertFilterExpression
+type ReadAlertMetricFilterLinkResult = alertDefinitionFieldsFlatConditionFlatAlertConditionExpressionValueFlatAlertConditionNodeMetricFilterFlatAlertFilterExpressionLinksNamedLinks
+type ReadAlertMetricFilterValueResult = alertDefinitionFieldsFlatConditionFlatAlertConditionExpressionValueFlatAlertConditionNodeMetricFilterFlatAlertFilterExpressionValueAlertFilterExpression
+
+// Exported List types
+type ListAlertDefinitionsResult = listAlertDefinitionsAlertQueriesAlertDefinitionsAlertDefinitionsResult
+type AlertDefinitionsPaginator = Paginator[*ListAlertDefinitionsResult, ReadAlertDefinitionResult]
+
+// AlertEvaluation is the result of evaluating an alert condition for a single target, usually an
+// entity. EvaluatedCondition holds the condition nodes with the value each node evaluated to.
+type AlertEvaluation struct {
+	// The GraphQL type of the evaluation, e.g. AlertMetricConditionEvaluation.
+	Typename           string
+	Target             *AlertEvaluationTarget
+	State              AlertConditionState
+	ChangedAt          string
+	LastTriggeredAt    *string
+	Entity             *AlertEvaluationEntity
+	EvaluatedCondition []AlertEvaluatedConditionNode
+}
+
+// EntityId returns the id of the evaluated entity, or an empty string if the target is not an entity.
+func (e *AlertEvaluation) EntityId() string {
+	if e.Target != nil && e.Target.EntityId != nil {
+		return *e.Target.EntityId
+	}
+	if e.Entity != nil {
+		return e.Entity.EntityId
+	}
+	return ""
+}
+
+// Firing reports whether the condition fires for the target.
+func (e *AlertEvaluation) Firing() bool {
+	return e.State == AlertConditionStateFiring
+}
 
 type AlertsCommunicator interface {
 	Create(context.Context, AlertDefinitionInput) (*CreateAlertDefinitionResult, error)
 	Read(context.Context, string) (*ReadAlertDefinitionResult, error)
 	Update(context.Context, string, AlertDefinitionInput) (*UpdateAlertDefinitionResult, error)
 	Delete(context.Context, string) error
+	List(context.Context, AlertFilterInput, *ListOptions) (*ListAlertDefinitionsResult, error)
+	All(context.Context, AlertFilterInput, *ListOptions) *AlertDefinitionsPaginator
+	Evaluate(context.Context, []AlertConditionNodeInput) ([]AlertEvaluation, error)
+	Patch(context.Context, AlertDefinitionPatchInput) (*ReadAlertDefinitionResult, error)
+	BulkUpdate(context.Context, []string, AlertDefinitionBulkInput) ([]ReadAlertDefinitionResult, error)
+	DeleteMany(context.Context, []string) ([]string, error)
+	Mute(context.Context, []AlertMuteOrUnmuteTargetInput, *time.Time) error
+	Unmute(context.Context, []AlertMuteOrUnmuteTargetInput) error
+	Acknowledge(context.Context, AcknowledgementTargetInput) error
+	ResetEvaluation(context.Context, string) error
+	ResetEvaluations(context.Context, []string) (int, error)
+	Statistics(context.Context, AlertFilterInput, ...AlertDefinitionStatisticsCategory) ([]AlertStatistics, error)
+	EvaluationStatistics(context.Context, AlertEvaluationFilterInput, ...AlertEvaluationStatisticsCategory) ([]AlertStatistics, error)
+	ManagementEvents(context.Context, AlertManagementEventsFilterInput, *ListOptions) (*AlertManagementEventsResult, error)
+	ManagementEventsAll(context.Context, AlertManagementEventsFilterInput, *ListOptions) *AlertManagementEventsPaginator
+	Watch(context.Context, ActiveAlertsFilterInput, time.Duration) (<-chan AlertEvent, error)
 }
 
 func newAlertsService(c *Client) *AlertsService {
 	return &AlertsService{c}
 }
 
+// The alert api exchanges mute timestamps in ISO-8601 format.
+const AlertMuteTimeFormat = time.RFC3339
+
+// AlertMuteDefinition returns a mute target covering every entity of the alert definition.
+func AlertMuteDefinition(alertDefinitionId string) AlertMuteOrUnmuteTargetInput {
+	return AlertMuteOrUnmuteTargetInput{AlertDefinitionId: &alertDefinitionId}
+}
+
+// AlertMuteEntity returns a mute target covering every alert definition of the entity.
+func AlertMuteEntity(entityId string) AlertMuteOrUnmuteTargetInput {
+	return AlertMuteOrUnmuteTargetInput{EntityId: &entityId}
+}
+
+// AlertMuteDefinitionOnEntity returns a mute target covering only the given entity of the alert
+// definition.
+func AlertMuteDefinitionOnEntity(alertDefinitionId string, entityId string) AlertMuteOrUnmuteTargetInput {
+	return AlertMuteOrUnmuteTargetInput{AlertDefinitionId: &alertDefinitionId, EntityId: &entityId}
+}
+
+// validateMuteTargets checks that there is at least one target and that every target has an
+// alert definition or an entity.
+func validateMuteTargets(targets []AlertMuteOrUnmuteTargetInput) error {
+	if len(targets) == 0 {
+		return errors.New("alert mute requires at least one target")
+	}
+
+	for i, target := range targets {
+		if target.AlertDefinitionId == nil && target.EntityId == nil {
+			return fmt.Errorf("alert mute target %d requires an alert definition id or an entity id", i)
+		}
+	}
+
+	return nil
+}
+
 // Create creates a new alert with the given definition.
 func (as *AlertsService) Create(ctx context.Context, input AlertDefinitionInput) (*CreateAlertDefinitionResult, error) {
-	log.Printf("Create alert request. Name: %s", input.Name)
+	as.client.logger.DebugContext(ctx, "create alert request", "name", input.Name)
 
 	resp, err := createAlertDefinitionMutation(ctx, as.client.gql, input)
 	if err != nil {
@@ -47,13 +138,13 @@ func (as *AlertsService) Create(ctx context.Context, input AlertDefinitionInput)
 		return nil, ErrUnknown
 	}
 
-	log.Printf("Create alert success. Id: %s", alertDef.Id)
+	as.client.logger.DebugContext(ctx, "create alert success", "id", alertDef.Id)
 	return alertDef, nil
 }
 
 // Read returns the alert identified by the given Id.
 func (as *AlertsService) Read(ctx context.Context, id string) (*ReadAlertDefinitionResult, error) {
-	log.Printf("Read alert request. Id: %s", id)
+	as.client.logger.DebugContext(ctx, "read alert request", "id", id)
 
 	resp, err := getAlertDefinitionById(ctx, as.client.gql, id)
 	if err != nil {
@@ -65,13 +156,13 @@ func (as *AlertsService) Read(ctx context.Context, id string) (*ReadAlertDefinit
 		return nil, ErrNotFound
 	}
 
-	log.Printf("Read alert success. Id: %s", id)
+	as.client.logger.DebugContext(ctx, "read alert success", "id", id)
 	return &alertDefs[0], nil
 }
 
 // Update updates the alert with the given id.
 func (as *AlertsService) Update(ctx context.Context, id string, input AlertDefinitionInput) (*UpdateAlertDefinitionResult, error) {
-	log.Printf("Update alert request. Id: %s", id)
+	as.client.logger.DebugContext(ctx, "update alert request", "id", id)
 
 	resp, err := updateAlertDefinitionMutation(ctx, as.client.gql, input, id)
 	if err != nil {
@@ -80,17 +171,17 @@ func (as *AlertsService) Update(ctx context.Context, id string, input AlertDefin
 
 	result := resp.AlertMutations.UpdateAlertDefinition
 	if result == nil {
-		log.Printf("Alert not found. Id: %s", id)
+		as.client.logger.DebugContext(ctx, "alert not found", "id", id)
 		return nil, ErrNotFound
 	}
 
-	log.Printf("Update alert success. Id: %s", id)
+	as.client.logger.DebugContext(ctx, "update alert success", "id", id)
 	return result, nil
 }
 
 // Delete deletes the alert with the given id.
 func (as *AlertsService) Delete(ctx context.Context, id string) error {
-	log.Printf("Delete alert request. Id: %s", id)
+	as.client.logger.DebugContext(ctx, "delete alert request", "id", id)
 
 	r, err := deleteAlertDefinitionMutation(ctx, as.client.gql, id)
 	if err != nil {
@@ -99,10 +190,245 @@ func (as *AlertsService) Delete(ctx context.Context, id string) error {
 
 	idPtr := r.AlertMutations.DeleteAlertDefinition
 	if idPtr == nil || *idPtr != id {
-		log.Printf("Alert not found. Id: %s", id)
+		as.client.logger.DebugContext(ctx, "alert not found", "id", id)
+		return ErrNotFound
+	}
+
+	as.client.logger.DebugContext(ctx, "delete alert success", "id", id)
+	return nil
+}
+
+// Patch updates only the fields that are set in the input, leaving every other field of the
+// alert unchanged. Unlike Update it does not require the full alert definition. The API turns
+// triggerResetActions off when it is not set, so a nil TriggerResetActions is filled in with the
+// current value of the alert, which costs an extra read.
+func (as *AlertsService) Patch(ctx context.Context, input AlertDefinitionPatchInput) (*ReadAlertDefinitionResult, error) {
+	as.client.logger.DebugContext(ctx, "patch alert request", "id", input.Id)
+
+	if input.TriggerResetActions == nil {
+		current, err := as.Read(ctx, input.Id)
+		if err != nil {
+			return nil, err
+		}
+		input.TriggerResetActions = Ptr(current.TriggerResetActions)
+	}
+
+	resp, err := patchAlertDefinitionMutation(ctx, as.client.gql, input)
+	if err != nil {
+		return nil, err
+	}
+
+	result := resp.AlertMutations.PatchAlertDefinition
+	if result == nil {
+		as.client.logger.DebugContext(ctx, "alert not found", "id", input.Id)
+		return nil, ErrNotFound
+	}
+
+	as.client.logger.DebugContext(ctx, "patch alert success", "id", input.Id)
+	return result, nil
+}
+
+// BulkUpdate sets the fields of the input on every alert with one of the given ids, and returns
+// the updated alerts.
+func (as *AlertsService) BulkUpdate(ctx context.Context, ids []string, input AlertDefinitionBulkInput) ([]ReadAlertDefinitionResult, error) {
+	as.client.logger.DebugContext(ctx, "bulk update alerts request", "ids", ids)
+
+	resp, err := bulkUpdateAlertDefinitionsMutation(ctx, as.client.gql, ids, input)
+	if err != nil {
+		return nil, err
+	}
+
+	result := resp.AlertMutations.BulkUpdateAlertDefinitions
+	as.client.logger.DebugContext(ctx, "bulk update alerts success", "count", len(result))
+	return result, nil
+}
+
+// DeleteMany deletes the alerts with the given ids. It returns the ids that were not deleted,
+// along with an error wrapping ErrNotFound, when any of the alerts does not exist.
+func (as *AlertsService) DeleteMany(ctx context.Context, ids []string) ([]string, error) {
+	as.client.logger.DebugContext(ctx, "delete alerts request", "ids", ids)
+
+	resp, err := deleteAlertDefinitionsMutation(ctx, as.client.gql, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted := resp.AlertMutations.DeleteAlertDefinitions
+	var notDeleted []string
+	for _, id := range ids {
+		if !slices.Contains(deleted, id) {
+			notDeleted = append(notDeleted, id)
+		}
+	}
+
+	if len(notDeleted) > 0 {
+		as.client.logger.DebugContext(ctx, "alerts not found", "ids", notDeleted)
+		return notDeleted, fmt.Errorf("alerts %v: %w", notDeleted, ErrNotFound)
+	}
+
+	as.client.logger.DebugContext(ctx, "delete alerts success", "count", len(deleted))
+	return nil, nil
+}
+
+// List returns a single page of alerts matching the given filter.
+func (as *AlertsService) List(ctx context.Context, filter AlertFilterInput, opts *ListOptions) (*ListAlertDefinitionsResult, error) {
+	as.client.logger.DebugContext(ctx, "list alerts request")
+
+	resp, err := listAlertDefinitions(ctx, as.client.gql, filter, opts.paging(), opts.sortBy())
+	if err != nil {
+		return nil, err
+	}
+
+	page := &resp.AlertQueries.AlertDefinitions
+	as.client.logger.DebugContext(ctx, "list alerts success", "count", len(page.AlertDefinitions))
+	return page, nil
+}
+
+// All returns a paginator over every alert matching the given filter.
+func (as *AlertsService) All(ctx context.Context, filter AlertFilterInput, opts *ListOptions) *AlertDefinitionsPaginator {
+	return NewPaginator(ctx,
+		func(ctx context.Context, paging *PagingInput) (*ListAlertDefinitionsResult, error) {
+			return as.List(ctx, filter, &ListOptions{Paging: paging, SortBy: opts.sortBy()})
+		},
+		func(page *ListAlertDefinitionsResult) []ReadAlertDefinitionResult {
+			return page.AlertDefinitions
+		},
+		opts.paginatorOptions()...)
+}
+
+// Evaluate evaluates the condition on the server without saving it, and returns the result for
+// every target the condition applies to. Use it to preview what a new or changed condition
+// would fire on before creating or updating an alert.
+func (as *AlertsService) Evaluate(ctx context.Context, condition []AlertConditionNodeInput) ([]AlertEvaluation, error) {
+	as.client.logger.DebugContext(ctx, "evaluate alert condition request", "nodes", len(condition))
+
+	resp, err := evaluateAlertCondition(ctx, as.client.gql, condition)
+	if err != nil {
+		return nil, err
+	}
+
+	evaluations := make([]AlertEvaluation, 0, len(resp.AlertQueries.EvaluateAlertConditionV2))
+	for _, evaluation := range resp.AlertQueries.EvaluateAlertConditionV2 {
+		result := AlertEvaluation{
+			Target:             evaluation.GetTarget(),
+			State:              evaluation.GetState(),
+			ChangedAt:          evaluation.GetChangedAt(),
+			LastTriggeredAt:    evaluation.GetLastTriggeredAt(),
+			Entity:             evaluation.GetEntity(),
+			EvaluatedCondition: evaluation.GetEvaluatedCondition(),
+		}
+		if typename := evaluation.GetTypename(); typename != nil {
+			result.Typename = *typename
+		}
+		evaluations = append(evaluations, result)
+	}
+
+	as.client.logger.DebugContext(ctx, "evaluate alert condition success", "count", len(evaluations))
+	return evaluations, nil
+}
+
+// Mute suppresses the notifications of the given targets until the given time. When until is nil
+// the targets are muted until the alerts are resolved. The change is reflected in the muteInfo
+// of the alert definitions.
+func (as *AlertsService) Mute(ctx context.Context, targets []AlertMuteOrUnmuteTargetInput, until *time.Time) error {
+	as.client.logger.DebugContext(ctx, "mute alerts request", "targets", len(targets))
+
+	if err := validateMuteTargets(targets); err != nil {
+		return err
+	}
+
+	var untilStr *string
+	if until != nil {
+		untilStr = Ptr(until.UTC().Format(AlertMuteTimeFormat))
+	}
+
+	_, err := doMutate(ctx,
+		func(ctx context.Context) (*muteAlertsMutationResponse, error) {
+			return muteAlertsMutation(ctx, as.client.gql, targets, untilStr)
+		},
+		func(resp *muteAlertsMutationResponse) error {
+			if muted := resp.AlertMutations.MuteAlerts; muted == nil || !*muted {
+				return mutateUnknownError("mute alerts failed")
+			}
+			return nil
+		})
+	if err != nil {
+		return err
+	}
+
+	as.client.logger.DebugContext(ctx, "mute alerts success", "targets", len(targets))
+	return nil
+}
+
+// Unmute resumes the notifications of the given targets.
+func (as *AlertsService) Unmute(ctx context.Context, targets []AlertMuteOrUnmuteTargetInput) error {
+	as.client.logger.DebugContext(ctx, "unmute alerts request", "targets", len(targets))
+
+	if err := validateMuteTargets(targets); err != nil {
+		return err
+	}
+
+	_, err := doMutate(ctx,
+		func(ctx context.Context) (*unmuteAlertsMutationResponse, error) {
+			return unmuteAlertsMutation(ctx, as.client.gql, targets)
+		},
+		func(resp *unmuteAlertsMutationResponse) error {
+			if unmuted := resp.AlertMutations.UnmuteAlerts; unmuted == nil || !*unmuted {
+				return mutateUnknownError("unmute alerts failed")
+			}
+			return nil
+		})
+	if err != nil {
+		return err
+	}
+
+	as.client.logger.DebugContext(ctx, "unmute alerts success", "targets", len(targets))
+	return nil
+}
+
+// Acknowledge acknowledges the active alert with the target trigger id. The alert is acknowledged
+// by the current user unless the target sets another user.
+func (as *AlertsService) Acknowledge(ctx context.Context, target AcknowledgementTargetInput) error {
+	as.client.logger.DebugContext(ctx, "acknowledge alert request", "trigger_id", target.TriggerId)
+
+	resp, err := acknowledgeAlertMutation(ctx, as.client.gql, &target)
+	if err != nil {
+		return err
+	}
+
+	if resp.AlertMutations.AcknowledgeAlert == nil {
+		as.client.logger.DebugContext(ctx, "alert trigger not found", "trigger_id", target.TriggerId)
 		return ErrNotFound
 	}
 
-	log.Printf("Delete alert success. Id: %s", id)
+	as.client.logger.DebugContext(ctx, "acknowledge alert success", "trigger_id", target.TriggerId)
 	return nil
 }
+
+// ResetEvaluation puts every entity of the alert with the given id back to the OK state, so a new
+// notification is sent if the alert fires again.
+func (as *AlertsService) ResetEvaluation(ctx context.Context, id string) error {
+	as.client.logger.DebugContext(ctx, "reset alert evaluation request", "id", id)
+
+	if _, err := resetAlertEvaluationMutation(ctx, as.client.gql, id); err != nil {
+		return err
+	}
+
+	as.client.logger.DebugContext(ctx, "reset alert evaluation success", "id", id)
+	return nil
+}
+
+// ResetEvaluations resets the evaluations of the alerts with the given ids, and returns the
+// number of evaluations that were reset.
+func (as *AlertsService) ResetEvaluations(ctx context.Context, ids []string) (int, error) {
+	as.client.logger.DebugContext(ctx, "reset alert evaluations request", "ids", ids)
+
+	resp, err := resetAlertEvaluationsMutation(ctx, as.client.gql, ids)
+	if err != nil {
+		return 0, err
+	}
+
+	count := resp.AlertMutations.ResetAlertEvaluations
+	as.client.logger.DebugContext(ctx, "reset alert evaluations success", "count", count)
+	return count, nil
+}
@@ -1,8 +1,13 @@
 package client
 
 import (
+	"encoding/json"
+	"errors"
 	"net/http"
 	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
 )
 
 var (
@@ -70,31 +75,31 @@ var (
 			},
 		}
 	}
-	mockReadAlertDefinitionResult = func(id string, description string) *getAlertDefinitionByIdAlertQueriesAlertDefinitionsAlertDefinitionsResultAlertDefinitionsAlertDefinition {
+	mockReadAlertDefinitionResult = func(id string, description string) *alertDefinitionFields {
 		operatorGT := ">"
 		operatorMAX := "MAX"
 		metricFieldName := "Orion.NPM.InterfaceTraffic.InTotalBytes"
 
-		return &getAlertDefinitionByIdAlertQueriesAlertDefinitionsAlertDefinitionsResultAlertDefinitionsAlertDefinition{
+		return &alertDefinitionFields{
 			Id:             id,
 			Name:           "swo-client-go [test-alert]",
 			Description:    &description,
 			Enabled:        false,
 			OrganizationId: "140638900734749696",
-			User: &getAlertDefinitionByIdAlertQueriesAlertDefinitionsAlertDefinitionsResultAlertDefinitionsAlertDefinitionUser{
+			User: &alertDefinitionFieldsUser{
 				Id: "151686710111094784",
 			},
 			Severity:          "INFO",
 			Triggered:         false,
 			TargetEntityTypes: []string{"DeviceVolume"},
-			MuteInfo: getAlertDefinitionByIdAlertQueriesAlertDefinitionsAlertDefinitionsResultAlertDefinitionsAlertDefinitionMuteInfo{
+			MuteInfo: alertDefinitionFieldsMuteInfoAlertDefinitionMuteInfo{
 				Muted: false,
 			},
-			Actions:             []getAlertDefinitionByIdAlertQueriesAlertDefinitionsAlertDefinitionsResultAlertDefinitionsAlertDefinitionActionsAlertAction{},
+			Actions:             []alertDefinitionFieldsActionsAlertAction{},
 			TriggerResetActions: false,
 			TriggerDelaySeconds: 300,
 			ConditionType:       "ENTITY_METRIC",
-			FlatCondition: []getAlertDefinitionByIdAlertQueriesAlertDefinitionsAlertDefinitionsResultAlertDefinitionsAlertDefinitionFlatConditionFlatAlertConditionExpression{
+			FlatCondition: []alertDefinitionFieldsFlatConditionFlatAlertConditionExpression{
 				{
 					Id: "935b93f6-f94f-4b25-98a6-e66bbf80eaee",
 					Links: []ReadAlertConditionLinkResult{
@@ -238,7 +243,7 @@ func TestReadAlert(t *testing.T) {
 		sendGraphQLResponse(t, w, getAlertDefinitionByIdResponse{
 			AlertQueries: getAlertDefinitionByIdAlertQueries{
 				AlertDefinitions: getAlertDefinitionByIdAlertQueriesAlertDefinitionsAlertDefinitionsResult{
-					AlertDefinitions: []getAlertDefinitionByIdAlertQueriesAlertDefinitionsAlertDefinitionsResultAlertDefinitionsAlertDefinition{
+					AlertDefinitions: []alertDefinitionFields{
 						*mockReadAlertDefinitionResult(gqlInput.Id, mockAlertDescription),
 					},
 				},
@@ -347,4 +352,591 @@ func TestSwoService_AlertsServerErrors(t *testing.T) {
 	if err == nil {
 		t.Error("Swo.AlertServerErrors expected an error response")
 	}
+	_, err = client.AlertsService().Evaluate(ctx, nil)
+	if err == nil {
+		t.Error("Swo.AlertServerErrors expected an error response")
+	}
+	_, err = client.AlertsService().Patch(ctx, AlertDefinitionPatchInput{Id: "123"})
+	if err == nil {
+		t.Error("Swo.AlertServerErrors expected an error response")
+	}
+	_, err = client.AlertsService().BulkUpdate(ctx, []string{"123"}, AlertDefinitionBulkInput{})
+	if err == nil {
+		t.Error("Swo.AlertServerErrors expected an error response")
+	}
+	_, err = client.AlertsService().DeleteMany(ctx, []string{"123"})
+	if err == nil {
+		t.Error("Swo.AlertServerErrors expected an error response")
+	}
+	err = client.AlertsService().Mute(ctx, []AlertMuteOrUnmuteTargetInput{AlertMuteDefinition("123")}, nil)
+	if err == nil {
+		t.Error("Swo.AlertServerErrors expected an error response")
+	}
+	err = client.AlertsService().Unmute(ctx, []AlertMuteOrUnmuteTargetInput{AlertMuteDefinition("123")})
+	if err == nil {
+		t.Error("Swo.AlertServerErrors expected an error response")
+	}
+	err = client.AlertsService().Acknowledge(ctx, AcknowledgementTargetInput{TriggerId: "123"})
+	if err == nil {
+		t.Error("Swo.AlertServerErrors expected an error response")
+	}
+	err = client.AlertsService().ResetEvaluation(ctx, "123")
+	if err == nil {
+		t.Error("Swo.AlertServerErrors expected an error response")
+	}
+	_, err = client.AlertsService().ResetEvaluations(ctx, []string{"123"})
+	if err == nil {
+		t.Error("Swo.AlertServerErrors expected an error response")
+	}
+}
+
+func TestListAlerts(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	filter := AlertFilterInput{Enabled: Ptr(true), Severities: []AlertSeverity{AlertSeverityCritical}}
+	opts := &ListOptions{
+		Paging: &PagingInput{First: Ptr(10)},
+		SortBy: SortBy("name", SortDirectionAsc),
+	}
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__listAlertDefinitionsInput](r)
+		if err != nil {
+			t.Errorf("Swo.ListAlerts returned error: %v", err)
+		}
+
+		want := __listAlertDefinitionsInput{Filter: filter, Paging: opts.Paging, SortBy: opts.SortBy}
+		if !testObjects(t, *gqlInput, want) {
+			t.Errorf("Request input = %+v, want %+v", *gqlInput, want)
+		}
+
+		sendGraphQLResponse(t, w, listAlertDefinitionsResponse{
+			AlertQueries: listAlertDefinitionsAlertQueries{
+				AlertDefinitions: ListAlertDefinitionsResult{
+					AlertDefinitions: []ReadAlertDefinitionResult{*mockReadAlertDefinitionResult(mockAlertId, mockAlertDescription)},
+					TotalRecords:     Ptr(1),
+				},
+			},
+		})
+	})
+
+	got, err := client.AlertsService().List(ctx, filter, opts)
+	if err != nil {
+		t.Errorf("Swo.ListAlerts error: %v", err)
+		return
+	}
+
+	want := &ListAlertDefinitionsResult{
+		AlertDefinitions: []ReadAlertDefinitionResult{*mockReadAlertDefinitionResult(mockAlertId, mockAlertDescription)},
+		TotalRecords:     Ptr(1),
+	}
+
+	if !testObjects(t, got, want) {
+		t.Errorf("Swo.ListAlerts returned %+v, wanted %+v", got, want)
+	}
+}
+
+func TestAllAlerts(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	pages := map[string]ListAlertDefinitionsResult{
+		"": {
+			AlertDefinitions: []ReadAlertDefinitionResult{
+				*mockReadAlertDefinitionResult("1", mockAlertDescription),
+				*mockReadAlertDefinitionResult("2", mockAlertDescription),
+			},
+			PageInfo: PageInfo{
+				EndCursor:   Ptr("cursor-2"),
+				HasNextPage: true,
+			},
+		},
+		"cursor-2": {
+			AlertDefinitions: []ReadAlertDefinitionResult{
+				*mockReadAlertDefinitionResult("3", mockAlertDescription),
+			},
+		},
+	}
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__listAlertDefinitionsInput](r)
+		if err != nil {
+			t.Errorf("Swo.AllAlerts returned error: %v", err)
+		}
+
+		if gqlInput.Paging == nil || gqlInput.Paging.First == nil || *gqlInput.Paging.First != 2 {
+			t.Errorf("Swo.AllAlerts expected page size to be kept, got %+v", gqlInput.Paging)
+		}
+
+		cursor := ""
+		if gqlInput.Paging != nil && gqlInput.Paging.After != nil {
+			cursor = *gqlInput.Paging.After
+		}
+
+		sendGraphQLResponse(t, w, listAlertDefinitionsResponse{
+			AlertQueries: listAlertDefinitionsAlertQueries{
+				AlertDefinitions: pages[cursor],
+			},
+		})
+	})
+
+	var got []string
+	for alert, err := range client.AlertsService().All(ctx, AlertFilterInput{}, &ListOptions{Paging: &PagingInput{First: Ptr(2)}}).Items() {
+		if err != nil {
+			t.Errorf("Swo.AllAlerts error: %v", err)
+			return
+		}
+		got = append(got, alert.Id)
+	}
+
+	want := []string{"1", "2", "3"}
+
+	if !testObjects(t, got, want) {
+		t.Errorf("Swo.AllAlerts returned %+v, wanted %+v", got, want)
+	}
+}
+
+func TestAllAlertsServerError(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", httpErrorResponse)
+
+	count := 0
+	for alert, err := range client.AlertsService().All(ctx, AlertFilterInput{}, nil).Items() {
+		count++
+		if err == nil || alert != nil {
+			t.Errorf("Swo.AllAlertsServerError expected an error, got %+v", alert)
+		}
+	}
+
+	if count != 1 {
+		t.Errorf("Swo.AllAlertsServerError yielded %d times, want 1", count)
+	}
+}
+
+func TestEvaluateAlert(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	condition := mockAlertDefinitionInput(mockAlertName, mockAlertDescription).Condition
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__evaluateAlertConditionInput](r)
+		if err != nil {
+			t.Errorf("Swo.EvaluateAlert returned error: %v", err)
+		}
+
+		if !testObjects(t, gqlInput.Condition, condition) {
+			t.Errorf("Request input = %+v, want %+v", gqlInput.Condition, condition)
+		}
+
+		sendGraphQLResponse(t, w, []byte(`{"data": {"alertQueries": {"evaluateAlertConditionV2": [
+			{
+				"__typename": "AlertMetricConditionEvaluation",
+				"target": {"entityId": "e-1", "tags": [{"key": "volume", "value": "C:"}]},
+				"state": "FIRING",
+				"changedAt": "2024-01-01T00:00:00Z",
+				"lastTriggeredAt": "2024-01-01T00:00:00Z",
+				"evaluatedCondition": [{
+					"id": "0",
+					"links": [{"name": "operands", "values": ["1", "4"]}],
+					"value": {"type": "binaryOperator", "operator": ">", "result": {"type": "BOOLEAN", "boolean": true}}
+				}]
+			},
+			{
+				"__typename": "AlertMetricConditionEvaluation",
+				"entity": {"entityId": "e-2", "currentState": "OK"},
+				"state": "OK",
+				"changedAt": "2024-01-01T00:00:00Z",
+				"evaluatedCondition": []
+			}
+		]}}}`))
+	})
+
+	got, err := client.AlertsService().Evaluate(ctx, condition)
+	if err != nil {
+		t.Fatalf("Swo.EvaluateAlert error: %v", err)
+	}
+
+	want := []AlertEvaluation{
+		{
+			Typename: "AlertMetricConditionEvaluation",
+			Target: &AlertEvaluationTarget{
+				EntityId: Ptr("e-1"),
+				Tags:     []AlertEvaluationTag{{Key: "volume", Value: "C:"}},
+			},
+			State:           AlertConditionStateFiring,
+			ChangedAt:       "2024-01-01T00:00:00Z",
+			LastTriggeredAt: Ptr("2024-01-01T00:00:00Z"),
+			EvaluatedCondition: []AlertEvaluatedConditionNode{{
+				Id:    "0",
+				Links: []AlertEvaluatedConditionLink{{Name: "operands", Values: []string{"1", "4"}}},
+				Value: &AlertEvaluatedConditionValue{
+					Type:     "binaryOperator",
+					Operator: Ptr(">"),
+					Result:   &AlertEvaluatedResult{Type: Ptr(EvalResultTypeBoolean), Boolean: Ptr(true)},
+				},
+			}},
+		},
+		{
+			Typename:           "AlertMetricConditionEvaluation",
+			Entity:             &AlertEvaluationEntity{EntityId: "e-2", CurrentState: AlertConditionStateOk},
+			State:              AlertConditionStateOk,
+			ChangedAt:          "2024-01-01T00:00:00Z",
+			EvaluatedCondition: []AlertEvaluatedConditionNode{},
+		},
+	}
+
+	if !testObjects(t, got, want) {
+		t.Errorf("Swo.EvaluateAlert returned %+v, want %+v", got, want)
+	}
+
+	if got[0].EntityId() != "e-1" || !got[0].Firing() {
+		t.Errorf("Swo.EvaluateAlert first evaluation = %s firing %v, want e-1 firing", got[0].EntityId(), got[0].Firing())
+	}
+	if got[1].EntityId() != "e-2" || got[1].Firing() {
+		t.Errorf("Swo.EvaluateAlert second evaluation = %s firing %v, want e-2 not firing", got[1].EntityId(), got[1].Firing())
+	}
+}
+
+func TestPatchAlert(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	input := AlertDefinitionPatchInput{Id: mockAlertId, Enabled: Ptr(true)}
+	current := mockReadAlertDefinitionResult(mockAlertId, mockAlertDescription)
+	current.TriggerResetActions = true
+
+	var operations []string
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		operation, err := getGraphQLOperation(r)
+		if err != nil {
+			t.Errorf("Swo.PatchAlert returned error: %v", err)
+		}
+		operations = append(operations, operation)
+
+		if operation == "getAlertDefinitionById" {
+			sendGraphQLResponse(t, w, getAlertDefinitionByIdResponse{
+				AlertQueries: getAlertDefinitionByIdAlertQueries{
+					AlertDefinitions: getAlertDefinitionByIdAlertQueriesAlertDefinitionsAlertDefinitionsResult{
+						AlertDefinitions: []ReadAlertDefinitionResult{*current},
+					},
+				},
+			})
+			return
+		}
+
+		var request struct {
+			Variables struct {
+				AlertDefinitionPatch map[string]any `json:"alertDefinitionPatch"`
+			} `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Errorf("Swo.PatchAlert returned error: %v", err)
+		}
+
+		// Only the fields that are set are sent, so the others keep their current value. The
+		// API resets triggerResetActions when it is missing, so its current value is sent.
+		got := request.Variables.AlertDefinitionPatch
+		want := map[string]any{"id": mockAlertId, "enabled": true, "triggerResetActions": true}
+		if !testObjects(t, got, want) {
+			t.Errorf("Request input = %+v, want %+v", got, want)
+		}
+
+		sendGraphQLResponse(t, w, patchAlertDefinitionMutationResponse{
+			AlertMutations: patchAlertDefinitionMutationAlertMutations{
+				PatchAlertDefinition: mockReadAlertDefinitionResult(mockAlertId, mockAlertDescription),
+			},
+		})
+	})
+
+	got, err := client.AlertsService().Patch(ctx, input)
+	if err != nil {
+		t.Fatalf("Swo.PatchAlert returned error: %v", err)
+	}
+
+	want := mockReadAlertDefinitionResult(mockAlertId, mockAlertDescription)
+	if !testObjects(t, got, want) {
+		t.Errorf("Swo.PatchAlert returned %+v, want %+v", got, want)
+	}
+	if want := []string{"getAlertDefinitionById", "patchAlertDefinitionMutation"}; !testObjects(t, operations, want) {
+		t.Errorf("Swo.PatchAlert sent %v, want %v", operations, want)
+	}
+
+	// A set TriggerResetActions is sent as is, without reading the alert.
+	operations = nil
+	input.TriggerResetActions = Ptr(true)
+	if _, err := client.AlertsService().Patch(ctx, input); err != nil {
+		t.Fatalf("Swo.PatchAlert returned error: %v", err)
+	}
+	if want := []string{"patchAlertDefinitionMutation"}; !testObjects(t, operations, want) {
+		t.Errorf("Swo.PatchAlert sent %v, want %v", operations, want)
+	}
+}
+
+func TestPatchAlertNotFound(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		sendGraphQLResponse(t, w, patchAlertDefinitionMutationResponse{})
+	})
+
+	_, err := client.AlertsService().Patch(ctx, AlertDefinitionPatchInput{Id: mockAlertId, Enabled: Ptr(false)})
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Swo.PatchAlert error = %v, want %v", err, ErrNotFound)
+	}
+}
+
+func TestBulkUpdateAlerts(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	ids := []string{mockAlertId, "2"}
+	input := AlertDefinitionBulkInput{Enabled: Ptr(false)}
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__bulkUpdateAlertDefinitionsMutationInput](r)
+		if err != nil {
+			t.Errorf("Swo.BulkUpdateAlerts returned error: %v", err)
+		}
+
+		want := __bulkUpdateAlertDefinitionsMutationInput{Ids: ids, BulkDefinition: input}
+		if !testObjects(t, *gqlInput, want) {
+			t.Errorf("Request input = %+v, want %+v", *gqlInput, want)
+		}
+
+		sendGraphQLResponse(t, w, bulkUpdateAlertDefinitionsMutationResponse{
+			AlertMutations: bulkUpdateAlertDefinitionsMutationAlertMutations{
+				BulkUpdateAlertDefinitions: []ReadAlertDefinitionResult{
+					*mockReadAlertDefinitionResult(mockAlertId, mockAlertDescription),
+					*mockReadAlertDefinitionResult("2", mockAlertDescription),
+				},
+			},
+		})
+	})
+
+	got, err := client.AlertsService().BulkUpdate(ctx, ids, input)
+	if err != nil {
+		t.Fatalf("Swo.BulkUpdateAlerts returned error: %v", err)
+	}
+
+	if len(got) != 2 || got[0].Id != mockAlertId || got[1].Id != "2" {
+		t.Errorf("Swo.BulkUpdateAlerts returned %+v", got)
+	}
+}
+
+func TestDeleteManyAlerts(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__deleteAlertDefinitionsMutationInput](r)
+		if err != nil {
+			t.Errorf("Swo.DeleteManyAlerts returned error: %v", err)
+		}
+
+		// Every id except "missing" exists.
+		var deleted []string
+		for _, id := range gqlInput.Ids {
+			if id != "missing" {
+				deleted = append(deleted, id)
+			}
+		}
+
+		sendGraphQLResponse(t, w, deleteAlertDefinitionsMutationResponse{
+			AlertMutations: deleteAlertDefinitionsMutationAlertMutations{DeleteAlertDefinitions: deleted},
+		})
+	})
+
+	notDeleted, err := client.AlertsService().DeleteMany(ctx, []string{mockAlertId, "2"})
+	if err != nil || notDeleted != nil {
+		t.Errorf("Swo.DeleteManyAlerts returned %v, %v, want no error", notDeleted, err)
+	}
+
+	notDeleted, err = client.AlertsService().DeleteMany(ctx, []string{mockAlertId, "missing"})
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Swo.DeleteManyAlerts error = %v, want %v", err, ErrNotFound)
+	}
+	if !testObjects(t, notDeleted, []string{"missing"}) {
+		t.Errorf("Swo.DeleteManyAlerts returned %v, want [missing]", notDeleted)
+	}
+}
+
+func TestMuteAlert(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	until := time.Date(2024, 5, 1, 12, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+	targets := []AlertMuteOrUnmuteTargetInput{AlertMuteDefinitionOnEntity(mockAlertId, "e-1")}
+	muteInfo := ReadAlertMuteInfoResult{}
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		request := new(graphql.Request)
+		if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+			t.Fatalf("Swo.MuteAlert returned error: %v", err)
+		}
+		variables, _ := json.Marshal(request.Variables)
+
+		switch request.OpName {
+		case "muteAlertsMutation":
+			var gqlInput __muteAlertsMutationInput
+			_ = json.Unmarshal(variables, &gqlInput)
+
+			want := __muteAlertsMutationInput{Targets: targets, Until: Ptr("2024-05-01T10:00:00Z")}
+			if !testObjects(t, gqlInput, want) {
+				t.Errorf("Request input = %+v, want %+v", gqlInput, want)
+			}
+
+			muteInfo = ReadAlertMuteInfoResult{Muted: true, Until: gqlInput.Until}
+			sendGraphQLResponse(t, w, muteAlertsMutationResponse{
+				AlertMutations: muteAlertsMutationAlertMutations{MuteAlerts: Ptr(true)},
+			})
+		case "unmuteAlertsMutation":
+			muteInfo = ReadAlertMuteInfoResult{}
+			sendGraphQLResponse(t, w, unmuteAlertsMutationResponse{
+				AlertMutations: unmuteAlertsMutationAlertMutations{UnmuteAlerts: Ptr(true)},
+			})
+		case "getAlertDefinitionById":
+			alert := mockReadAlertDefinitionResult(mockAlertId, mockAlertDescription)
+			alert.MuteInfo = muteInfo
+			sendGraphQLResponse(t, w, getAlertDefinitionByIdResponse{
+				AlertQueries: getAlertDefinitionByIdAlertQueries{
+					AlertDefinitions: getAlertDefinitionByIdAlertQueriesAlertDefinitionsAlertDefinitionsResult{
+						AlertDefinitions: []ReadAlertDefinitionResult{*alert},
+					},
+				},
+			})
+		default:
+			t.Errorf("Swo.MuteAlert unexpected operation %s", request.OpName)
+		}
+	})
+
+	if err := client.AlertsService().Mute(ctx, targets, &until); err != nil {
+		t.Fatalf("Swo.MuteAlert returned error: %v", err)
+	}
+
+	alert, err := client.AlertsService().Read(ctx, mockAlertId)
+	if err != nil {
+		t.Fatalf("Swo.ReadAlert returned error: %v", err)
+	}
+	want := ReadAlertMuteInfoResult{Muted: true, Until: Ptr("2024-05-01T10:00:00Z")}
+	if !testObjects(t, alert.MuteInfo, want) {
+		t.Errorf("Swo.ReadAlert muteInfo = %+v, want %+v", alert.MuteInfo, want)
+	}
+
+	if err := client.AlertsService().Unmute(ctx, targets); err != nil {
+		t.Fatalf("Swo.UnmuteAlert returned error: %v", err)
+	}
+
+	alert, err = client.AlertsService().Read(ctx, mockAlertId)
+	if err != nil {
+		t.Fatalf("Swo.ReadAlert returned error: %v", err)
+	}
+	if alert.MuteInfo.Muted {
+		t.Errorf("Swo.ReadAlert muteInfo = %+v, want unmuted", alert.MuteInfo)
+	}
+}
+
+func TestMuteAlertFailed(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		sendGraphQLResponse(t, w, []byte(`{"data":{"alertMutations":{"muteAlerts":false,"unmuteAlerts":null}}}`))
+	})
+
+	targets := []AlertMuteOrUnmuteTargetInput{AlertMuteDefinition("123")}
+
+	err := client.AlertsService().Mute(ctx, targets, nil)
+	var apiErr *APIError
+	if !errors.Is(err, ErrUnknown) || !errors.As(err, &apiErr) {
+		t.Fatalf("Swo.MuteAlert returned %v, want an *APIError wrapping ErrUnknown", err)
+	}
+	if apiErr.Operation != "muteAlertsMutation" || apiErr.RequestID == "" {
+		t.Errorf("Swo.MuteAlert error has operation %q and request id %q", apiErr.Operation, apiErr.RequestID)
+	}
+
+	err = client.AlertsService().Unmute(ctx, targets)
+	if !errors.Is(err, ErrUnknown) || !errors.As(err, &apiErr) {
+		t.Errorf("Swo.UnmuteAlert returned %v, want an *APIError wrapping ErrUnknown", err)
+	}
+}
+
+func TestMuteAlertInvalidTargets(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Swo.MuteAlert expected no request for invalid targets")
+	})
+
+	if err := client.AlertsService().Mute(ctx, nil, nil); err == nil {
+		t.Error("Swo.MuteAlert expected an error without targets")
+	}
+	if err := client.AlertsService().Unmute(ctx, []AlertMuteOrUnmuteTargetInput{{}}); err == nil {
+		t.Error("Swo.UnmuteAlert expected an error for an empty target")
+	}
+}
+
+func TestAcknowledgeAlert(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	target := AcknowledgementTargetInput{TriggerId: "trigger-1"}
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__acknowledgeAlertMutationInput](r)
+		if err != nil {
+			t.Errorf("Swo.AcknowledgeAlert returned error: %v", err)
+		}
+
+		if !testObjects(t, gqlInput.AcknowledgementTarget, &target) {
+			t.Errorf("Request input = %+v, want %+v", gqlInput.AcknowledgementTarget, target)
+		}
+
+		var acknowledged *string
+		if gqlInput.AcknowledgementTarget.TriggerId == target.TriggerId {
+			acknowledged = Ptr("ack-1")
+		}
+		sendGraphQLResponse(t, w, acknowledgeAlertMutationResponse{
+			AlertMutations: acknowledgeAlertMutationAlertMutations{AcknowledgeAlert: acknowledged},
+		})
+	})
+
+	if err := client.AlertsService().Acknowledge(ctx, target); err != nil {
+		t.Errorf("Swo.AcknowledgeAlert returned error: %v", err)
+	}
+}
+
+func TestResetAlertEvaluations(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	ids := []string{mockAlertId, "2"}
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__resetAlertEvaluationsMutationInput](r)
+		if err != nil {
+			t.Errorf("Swo.ResetAlertEvaluations returned error: %v", err)
+		}
+
+		if !testObjects(t, gqlInput.Ids, ids) {
+			t.Errorf("Request input = %+v, want %+v", gqlInput.Ids, ids)
+		}
+
+		sendGraphQLResponse(t, w, resetAlertEvaluationsMutationResponse{
+			AlertMutations: resetAlertEvaluationsMutationAlertMutations{ResetAlertEvaluations: 5},
+		})
+	})
+
+	got, err := client.AlertsService().ResetEvaluations(ctx, ids)
+	if err != nil {
+		t.Fatalf("Swo.ResetAlertEvaluations returned error: %v", err)
+	}
+	if got != 5 {
+		t.Errorf("Swo.ResetAlertEvaluations returned %d, want 5", got)
+	}
 }
@@ -1,7 +1,7 @@
 package client
 
 import (
-	"context"
+	"log/slog"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -18,21 +18,36 @@ type apiTokenAuthTransport struct {
 func (t *apiTokenAuthTransport) RoundTrip(request *http.Request) (*http.Response, error) {
 	// When using the RoundTipper interface it is important that the original request is not modified.
 	// See https://pkg.go.dev/net/http#RoundTripper for more details.
-	clone := request.Clone(context.Background())
+	clone := request.Clone(request.Context())
 
 	clone.Header.Set("Authorization", "Bearer "+t.apiToken)
 	clone.Header.Set("User-Agent", t.client.completeUserAgentString())
-	clone.Header.Set(requestIdentifier, uuid.NewString())
+	if clone.Header.Get(requestIdentifier) == "" {
+		clone.Header.Set(requestIdentifier, uuid.NewString())
+	}
+
+	ctx := clone.Context()
+	logger := t.client.logger
 
-	if t.client.debugMode {
-		DumpRequest(clone)
+	if t.client.debugMode && logger.Enabled(ctx, slog.LevelDebug) {
+		dump, err := dumpRequest(clone)
+		if err != nil {
+			logger.DebugContext(ctx, "error dumping request", "error", err)
+		} else {
+			logger.DebugContext(ctx, "http request", "request_id", clone.Header.Get(requestIdentifier), "dump", dump)
+		}
 	}
 
 	response, err := http.DefaultTransport.RoundTrip(clone)
 
 	// If response is nil then likely a client error (e.g. timeout).
-	if t.client.debugMode && response != nil {
-		DumpResponse(response)
+	if t.client.debugMode && response != nil && logger.Enabled(ctx, slog.LevelDebug) {
+		dump, err := dumpResponse(response)
+		if err != nil {
+			logger.DebugContext(ctx, "error dumping response", "error", err)
+		} else {
+			logger.DebugContext(ctx, "http response", "request_id", clone.Header.Get(requestIdentifier), "dump", dump)
+		}
 	}
 
 	return response, err
@@ -2,9 +2,6 @@ package client
 
 import (
 	"context"
-	"errors"
-	"fmt"
-	"log"
 )
 
 type ApiTokenService service
@@ -25,15 +22,15 @@ func newApiTokenService(c *Client) *ApiTokenService {
 
 // Creates a new ApiToken entity with the given input.
 func (as *ApiTokenService) Create(ctx context.Context, input CreateTokenInput) (*CreateApiTokenResult, error) {
-	log.Printf("create apiToken request. name=%s", input.Name)
+	as.client.logger.DebugContext(ctx, "create apiToken request", "name", input.Name)
 
-	resp, err := doMutate(
-		func() (*createTokenMutationResponse, error) {
+	resp, err := doMutate(ctx,
+		func(ctx context.Context) (*createTokenMutationResponse, error) {
 			return createTokenMutation(ctx, as.client.gql, input)
 		},
 		func(resp *createTokenMutationResponse) error {
 			if !resp.CreateToken.Success {
-				return mutateError("update apiToken failed",
+				return mutateError("create apiToken failed",
 					resp.CreateToken.Code,
 					resp.CreateToken.Message)
 			}
@@ -45,14 +42,14 @@ func (as *ApiTokenService) Create(ctx context.Context, input CreateTokenInput) (
 	}
 
 	result := resp.CreateToken.Token
-	log.Printf("create apiToken success. id=%s", result.Id)
+	as.client.logger.DebugContext(ctx, "create apiToken success", "id", result.Id)
 
 	return result, nil
 }
 
 // Returns the ApiToken entity with the given Id.
 func (as *ApiTokenService) Read(ctx context.Context, id string) (*ReadApiTokenResult, error) {
-	log.Printf("read apiToken request. id=%s", id)
+	as.client.logger.DebugContext(ctx, "read apiToken request", "id", id)
 
 	resp, err := getApiTokenById(ctx, as.client.gql, id)
 	if err != nil {
@@ -60,7 +57,8 @@ func (as *ApiTokenService) Read(ctx context.Context, id string) (*ReadApiTokenRe
 	}
 
 	if len(resp.User.CurrentOrganization.Tokens) == 0 {
-		return nil, errors.New(fmt.Sprintf("api token not found. id=%s", id))
+		as.client.logger.DebugContext(ctx, "apiToken not found", "id", id)
+		return nil, ErrNotFound
 	}
 
 	return &resp.User.CurrentOrganization.Tokens[0], nil
@@ -68,10 +66,10 @@ func (as *ApiTokenService) Read(ctx context.Context, id string) (*ReadApiTokenRe
 
 // Updates the ApiToken with input for the given id.
 func (as *ApiTokenService) Update(ctx context.Context, input UpdateTokenInput) error {
-	log.Printf("update apiToken request. id=%s", input.Id)
+	as.client.logger.DebugContext(ctx, "update apiToken request", "id", input.Id)
 
-	if _, err := doMutate(
-		func() (*updateTokenMutationResponse, error) {
+	if _, err := doMutate(ctx,
+		func(ctx context.Context) (*updateTokenMutationResponse, error) {
 			return updateTokenMutation(ctx, as.client.gql, input)
 		},
 		func(resp *updateTokenMutationResponse) error {
@@ -85,21 +83,21 @@ func (as *ApiTokenService) Update(ctx context.Context, input UpdateTokenInput) e
 		return err
 	}
 
-	log.Printf("update apiToken success. id=%s", input.Id)
+	as.client.logger.DebugContext(ctx, "update apiToken success", "id", input.Id)
 	return nil
 }
 
 // Deletes the ApiToken with the given id.
 func (as *ApiTokenService) Delete(ctx context.Context, id string) error {
-	log.Printf("delete apiToken request. id=%s", id)
+	as.client.logger.DebugContext(ctx, "delete apiToken request", "id", id)
 
-	if _, err := doMutate(
-		func() (*deleteTokenMutationResponse, error) {
+	if _, err := doMutate(ctx,
+		func(ctx context.Context) (*deleteTokenMutationResponse, error) {
 			return deleteTokenMutation(ctx, as.client.gql, DeleteTokenInput{Id: id})
 		},
 		func(resp *deleteTokenMutationResponse) error {
 			if !resp.DeleteToken.Success {
-				return mutateError("update apiToken failed",
+				return mutateError("delete apiToken failed",
 					resp.DeleteToken.Code,
 					resp.DeleteToken.Message)
 			}
@@ -108,6 +106,6 @@ func (as *ApiTokenService) Delete(ctx context.Context, id string) error {
 		return err
 	}
 
-	log.Printf("delete apiToken success. id=%s", id)
+	as.client.logger.DebugContext(ctx, "delete apiToken success", "id", id)
 	return nil
 }
@@ -3,7 +3,6 @@ package client
 import (
 	"context"
 	"fmt"
-	"log"
 )
 
 type CircleCIIntegrationService service
@@ -25,7 +24,7 @@ func newCircleCIIntegrationService(c *Client) *CircleCIIntegrationService {
 
 // Creates a new CircleCI connection with the given name and optional API token.
 func (s *CircleCIIntegrationService) Create(ctx context.Context, name string, apiToken *string) (*CreateCircleCIConnectionResult, error) {
-	log.Printf("create CircleCI connection request. name=%s", name)
+	s.client.logger.DebugContext(ctx, "create CircleCI connection request", "name", name)
 
 	resp, err := createCircleCIConnection(ctx, s.client.gql, name, apiToken)
 	if err != nil {
@@ -33,14 +32,14 @@ func (s *CircleCIIntegrationService) Create(ctx context.Context, name string, ap
 	}
 
 	result := &resp.Vcs.CreateCircleCIConnection
-	log.Printf("create CircleCI connection success. id=%s", result.Id)
+	s.client.logger.DebugContext(ctx, "create CircleCI connection success", "id", result.Id)
 
 	return result, nil
 }
 
 // Returns the CircleCI connection with the given id.
 func (s *CircleCIIntegrationService) Read(ctx context.Context, id string) (*ReadCircleCIConnectionResult, error) {
-	log.Printf("read CircleCI connection request. id=%s", id)
+	s.client.logger.DebugContext(ctx, "read CircleCI connection request", "id", id)
 
 	resp, err := getCircleCIConnection(ctx, s.client.gql, id)
 	if err != nil {
@@ -48,7 +47,8 @@ func (s *CircleCIIntegrationService) Read(ctx context.Context, id string) (*Read
 	}
 
 	if resp.Vcs.GetCircleCIConnection == nil {
-		return nil, fmt.Errorf("CircleCI connection not found. id=%s", id)
+		s.client.logger.DebugContext(ctx, "CircleCI connection not found", "id", id)
+		return nil, ErrNotFound
 	}
 
 	return resp.Vcs.GetCircleCIConnection, nil
@@ -56,7 +56,7 @@ func (s *CircleCIIntegrationService) Read(ctx context.Context, id string) (*Read
 
 // Updates the CircleCI connection with the given id.
 func (s *CircleCIIntegrationService) Update(ctx context.Context, id string, name *string, apiToken *string) (*UpdateCircleCIConnectionResult, error) {
-	log.Printf("update CircleCI connection request. id=%s", id)
+	s.client.logger.DebugContext(ctx, "update CircleCI connection request", "id", id)
 
 	resp, err := updateCircleCIConnection(ctx, s.client.gql, id, name, apiToken)
 	if err != nil {
@@ -64,14 +64,14 @@ func (s *CircleCIIntegrationService) Update(ctx context.Context, id string, name
 	}
 
 	result := &resp.Vcs.UpdateCircleCIConnection
-	log.Printf("update CircleCI connection success. id=%s", result.Id)
+	s.client.logger.DebugContext(ctx, "update CircleCI connection success", "id", result.Id)
 
 	return result, nil
 }
 
 // Deletes the CircleCI connection with the given id.
 func (s *CircleCIIntegrationService) Delete(ctx context.Context, id string) error {
-	log.Printf("delete CircleCI connection request. id=%s", id)
+	s.client.logger.DebugContext(ctx, "delete CircleCI connection request", "id", id)
 
 	resp, err := deleteCircleCIConnection(ctx, s.client.gql, id)
 	if err != nil {
@@ -82,6 +82,6 @@ func (s *CircleCIIntegrationService) Delete(ctx context.Context, id string) erro
 		return fmt.Errorf("delete CircleCI connection failed. id=%s", id)
 	}
 
-	log.Printf("delete CircleCI connection success. id=%s", id)
+	s.client.logger.DebugContext(ctx, "delete CircleCI connection success", "id", id)
 	return nil
 }
@@ -1,16 +1,16 @@
 package client
 
 import (
-	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"time"
 
 	"github.com/Khan/genqlient/graphql"
-	"github.com/cenkalti/backoff/v5"
-	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -19,23 +19,31 @@ const (
 	defaultRequestTimeout = 30 * time.Second
 	clientIdentifier      = "Swo-Api-Go"
 	requestIdentifier     = "X-Request-Id"
-	expBackoffMaxInterval = 30 * time.Second
-	expBackoffMultiplyer  = 2
 )
 
 var (
-	ErrUnknown     = errors.New("unknown error")
-	ErrNotFound    = errors.New("not found")
-	ErrEntityIdNil = errors.New("entity id is nil")
+	ErrUnknown      = errors.New("unknown error")
+	ErrNotFound     = errors.New("not found")
+	ErrEntityIdNil  = errors.New("entity id is nil")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrValidation   = errors.New("validation failed")
+	ErrRateLimited  = errors.New("rate limited")
 )
 
 // ServiceAccessor defines an interface for talking to via domain-specific service constructs
 type ServiceAccessor interface {
+	AlertInstancesService() AlertInstancesCommunicator
 	AlertsService() AlertsCommunicator
 	CircleCIIntegrationService() CircleCIIntegrationCommunicator
+	DashboardCategoriesService() DashboardCategoriesCommunicator
 	DashboardsService() DashboardsCommunicator
+	EntitiesService() EntitiesCommunicator
 	LogFilterService() LogFilterCommunicator
+	MaintenanceWindowsService() MaintenanceWindowsCommunicator
 	NotificationsService() NotificationsCommunicator
+	SavedSearchesService() SavedSearchesCommunicator
+	TransactionService() TransactionCommunicator
 	UriService() UriCommunicator
 	WebsiteService() WebsiteCommunicator
 }
@@ -45,20 +53,38 @@ type Client struct {
 	// Option settings
 	baseURL        *url.URL
 	debugMode      bool
+	logger         *slog.Logger
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
 	requestTimeout time.Duration
 	userAgent      string
 	transport      http.RoundTripper
+	retryPolicy    RetryPolicy
+
+	// Rate limiting settings shared by all services
+	requestsPerSecond     float64
+	requestBurst          int
+	maxConcurrentRequests int
 
 	// GraphQL client
 	gql graphql.Client
 
+	// Time source for polling
+	clock clock
+
 	// Service accessors
+	alertInstancesService      AlertInstancesCommunicator
 	alertsService              AlertsCommunicator
 	apiTokenService            ApiTokenCommunicator
 	circleCIIntegrationService CircleCIIntegrationCommunicator
+	dashboardCategoriesService DashboardCategoriesCommunicator
 	dashboardsService          DashboardsCommunicator
+	entitiesService            EntitiesCommunicator
 	logFilterService           LogFilterCommunicator
+	maintenanceWindowsService  MaintenanceWindowsCommunicator
 	notificationsService       NotificationsCommunicator
+	savedSearchesService       SavedSearchesCommunicator
+	transactionService         TransactionCommunicator
 	uriService                 UriCommunicator
 	websiteService             WebsiteCommunicator
 }
@@ -69,15 +95,24 @@ type service struct {
 }
 
 type gqlClient struct {
-	httpClient *http.Client
+	httpClient  *http.Client
+	retryPolicy RetryPolicy
+	logger      *slog.Logger
+	telemetry   *telemetry
 }
 
 // Returns a new SWO API client with functional override options.
 // * BaseUrlOption
 // * DebugOption
+// * LoggerOption
+// * TracerProviderOption
+// * MeterProviderOption
 // * TransportOption
 // * UserAgentOption
 // * RequestTimeoutOption
+// * RetryPolicyOption
+// * RateLimitOption
+// * MaxConcurrentRequestsOption
 func New(apiToken string, opts ...ClientOption) (*Client, error) {
 	baseURL, err := url.Parse(defaultBaseURL)
 
@@ -88,16 +123,31 @@ func New(apiToken string, opts ...ClientOption) (*Client, error) {
 	swoClient := &Client{
 		baseURL:        baseURL,
 		requestTimeout: defaultRequestTimeout,
+		retryPolicy:    DefaultRetryPolicy(),
+		clock:          systemClock{},
 	}
 
 	// Set any user options that were provided.
+	var optionErrs []error
 	for _, opt := range opts {
-		err = opt(swoClient)
-		if err != nil {
-			log.Error(fmt.Errorf("client option error. fallback to default value: %s", err))
+		if err = opt(swoClient); err != nil {
+			optionErrs = append(optionErrs, err)
 		}
 	}
 
+	// The client is silent unless a logger is given or debug mode is on.
+	if swoClient.logger == nil {
+		if swoClient.debugMode {
+			swoClient.logger = newDebugLogger()
+		} else {
+			swoClient.logger = newDiscardLogger()
+		}
+	}
+
+	for _, err := range optionErrs {
+		swoClient.logger.Warn("client option error. fallback to default value", "error", err)
+	}
+
 	// Use the api token transport if one wasn't provided.
 	if swoClient.transport == nil {
 		swoClient.transport = &apiTokenAuthTransport{
@@ -106,17 +156,34 @@ func New(apiToken string, opts ...ClientOption) (*Client, error) {
 		}
 	}
 
+	if swoClient.requestsPerSecond > 0 || swoClient.maxConcurrentRequests > 0 {
+		swoClient.transport = newRateLimitTransport(swoClient.transport,
+			swoClient.requestsPerSecond, swoClient.requestBurst, swoClient.maxConcurrentRequests)
+	}
+
 	if swoClient.debugMode {
-		log.SetLevel(log.TraceLevel)
-		log.Info("swoclient: debugMode set to true.")
+		swoClient.logger.Debug("swoclient: debugMode set to true.")
+	}
+
+	telemetry, err := newTelemetry(swoClient.tracerProvider, swoClient.meterProvider)
+	if err != nil {
+		return nil, err
 	}
 
-	swoClient.gql = graphql.NewClient(swoClient.baseURL.String(), &gqlClient{
-		httpClient: &http.Client{
-			Timeout:   swoClient.requestTimeout,
-			Transport: swoClient.transport,
+	swoClient.gql = &instrumentedClient{
+		telemetry: telemetry,
+		client: &apiErrorClient{
+			client: graphql.NewClient(swoClient.baseURL.String(), &gqlClient{
+				httpClient: &http.Client{
+					Timeout:   swoClient.requestTimeout,
+					Transport: swoClient.transport,
+				},
+				retryPolicy: swoClient.retryPolicy,
+				logger:      swoClient.logger,
+				telemetry:   telemetry,
+			}),
 		},
-	})
+	}
 
 	if err = initServices(swoClient); err != nil {
 		return nil, err
@@ -126,37 +193,59 @@ func New(apiToken string, opts ...ClientOption) (*Client, error) {
 }
 
 func (c *gqlClient) Do(req *http.Request) (*http.Response, error) {
-	operation := func() (*http.Response, error) {
-		var err error
+	info := requestInfoFrom(req.Context())
+	if info != nil && req.Header.Get(requestIdentifier) == "" {
+		req.Header.Set(requestIdentifier, info.requestID)
+	}
+	c.telemetry.injectTraceContext(req)
+
+	return c.doWithRetry(req, func(req *http.Request) (*http.Response, error) {
+		start := time.Now()
 
 		resp, err := c.httpClient.Do(req)
 		if err != nil {
+			c.logger.WarnContext(req.Context(), "graphql request failed",
+				append(requestLogAttrs(req, info), "duration", time.Since(start), "error", err)...)
 			return nil, err
 		}
 
-		statusCode := resp.StatusCode
+		if info != nil {
+			info.statusCode = resp.StatusCode
 
-		if statusCode >= 500 && statusCode <= 599 {
-			return nil, fmt.Errorf("error returned by server: %d", statusCode)
+			// Prefer the server's request id when it reports one.
+			if id := resp.Header.Get(requestIdentifier); id != "" {
+				info.requestID = id
+			}
 		}
 
-		return resp, nil
-	}
+		c.logger.DebugContext(req.Context(), "graphql request",
+			append(requestLogAttrs(req, info), "status", resp.StatusCode, "duration", time.Since(start))...)
 
-	expBackoff := backoff.NewExponentialBackOff()
-	expBackoff.MaxInterval = expBackoffMaxInterval
-	expBackoff.Multiplier = expBackoffMultiplyer
+		return resp, nil
+	})
+}
 
-	return backoff.Retry(context.Background(), operation, backoff.WithBackOff(expBackoff), backoff.WithMaxElapsedTime(2*time.Minute))
+// requestLogAttrs returns the log attributes identifying a request.
+func requestLogAttrs(req *http.Request, info *requestInfo) []any {
+	if info == nil {
+		return []any{"request_id", req.Header.Get(requestIdentifier)}
+	}
+	return []any{"operation", info.operation, "request_id", info.requestID}
 }
 
 func initServices(c *Client) error {
+	c.alertInstancesService = newAlertInstancesService(c)
 	c.alertsService = newAlertsService(c)
 	c.apiTokenService = newApiTokenService(c)
 	c.circleCIIntegrationService = newCircleCIIntegrationService(c)
+	c.dashboardCategoriesService = newDashboardCategoriesService(c)
 	c.dashboardsService = newDashboardsService(c)
+	c.entitiesService = newEntitiesService(c)
 	c.logFilterService = newLogFilterService(c)
+	c.maintenanceWindowsService = newMaintenanceWindowsService(c)
 	c.notificationsService = newNotificationsService(c)
+	c.savedSearchesService = newSavedSearchesService(c)
+	c.transactionService = newTransactionService(c)
 	c.uriService = newUriService(c)
 	c.websiteService = newWebsiteService(c)
 
@@ -164,6 +253,11 @@ func initServices(c *Client) error {
 	return nil
 }
 
+// A subset of the API that deals with active and historical Alert instances.
+func (c *Client) AlertInstancesService() AlertInstancesCommunicator {
+	return c.alertInstancesService
+}
+
 // A subset of the API that deals with Alerts.
 func (c *Client) AlertsService() AlertsCommunicator {
 	return c.alertsService
@@ -179,21 +273,46 @@ func (c *Client) CircleCIIntegrationService() CircleCIIntegrationCommunicator {
 	return c.circleCIIntegrationService
 }
 
+// A subset of the API that deals with Dashboard Categories.
+func (c *Client) DashboardCategoriesService() DashboardCategoriesCommunicator {
+	return c.dashboardCategoriesService
+}
+
 // A subset of the API that deals with Dashboards.
 func (c *Client) DashboardsService() DashboardsCommunicator {
 	return c.dashboardsService
 }
 
+// A subset of the API that deals with searching Entities and their Relationships.
+func (c *Client) EntitiesService() EntitiesCommunicator {
+	return c.entitiesService
+}
+
 // A subset of the API that deals with LogFilters.
 func (c *Client) LogFilterService() LogFilterCommunicator {
 	return c.logFilterService
 }
 
+// A subset of the API that deals with Maintenance Windows.
+func (c *Client) MaintenanceWindowsService() MaintenanceWindowsCommunicator {
+	return c.maintenanceWindowsService
+}
+
 // A subset of the API that deals with Notifications.
 func (c *Client) NotificationsService() NotificationsCommunicator {
 	return c.notificationsService
 }
 
+// A subset of the API that deals with Saved Searches.
+func (c *Client) SavedSearchesService() SavedSearchesCommunicator {
+	return c.savedSearchesService
+}
+
+// A subset of the API that deals with Transactions (synthetic browser checks).
+func (c *Client) TransactionService() TransactionCommunicator {
+	return c.transactionService
+}
+
 // A subset of the API that deals with Uris.
 func (c *Client) UriService() UriCommunicator {
 	return c.uriService
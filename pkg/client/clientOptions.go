@@ -1,9 +1,15 @@
 package client
 
 import (
+	"errors"
+	"fmt"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"time"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // ClientOption provides functional option-setting behavior.
@@ -27,6 +33,48 @@ func RequestTimeoutOption(duration time.Duration) ClientOption {
 	}
 }
 
+// RetryPolicyOption is a config function allowing setting of the policy used to retry failed
+// requests. Zero values in the policy fall back to the defaults from DefaultRetryPolicy.
+func RetryPolicyOption(policy RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		if err := policy.validate(); err != nil {
+			return err
+		}
+		c.retryPolicy = policy.withDefaults()
+		return nil
+	}
+}
+
+// RateLimitOption is a config function allowing setting of the maximum rate of requests made
+// by the client, as a token bucket refilled at requestsPerSecond and holding up to burst
+// requests. The rate is slowed automatically when the server responds with 429.
+func RateLimitOption(requestsPerSecond float64, burst int) ClientOption {
+	return func(c *Client) error {
+		if requestsPerSecond <= 0 {
+			return fmt.Errorf("rate limit must be greater than zero: %v", requestsPerSecond)
+		}
+		if burst < 0 {
+			return fmt.Errorf("rate limit burst must not be negative: %d", burst)
+		}
+		c.requestsPerSecond = requestsPerSecond
+		c.requestBurst = burst
+		return nil
+	}
+}
+
+// MaxConcurrentRequestsOption is a config function allowing setting of the maximum number of
+// requests the client has in flight at once. Fewer are allowed for a while after the server
+// responds with 429.
+func MaxConcurrentRequestsOption(maxRequests int) ClientOption {
+	return func(c *Client) error {
+		if maxRequests <= 0 {
+			return fmt.Errorf("max concurrent requests must be greater than zero: %d", maxRequests)
+		}
+		c.maxConcurrentRequests = maxRequests
+		return nil
+	}
+}
+
 // TransportOption is a config function allowing setting of the http.Transport.
 func TransportOption(transport http.RoundTripper) ClientOption {
 	return func(c *Client) error {
@@ -52,10 +100,49 @@ func BaseUrlOption(urlString string) ClientOption {
 	}
 }
 
-// Sets the debug mode to on or off. Debug 'on' produces verbose logging to stdout.
+// Sets the debug mode to on or off. Debug 'on' logs every request and response, with secrets
+// redacted, at debug level. Without a LoggerOption the output goes to stderr.
 func DebugOption(on bool) ClientOption {
 	return func(c *Client) error {
 		c.debugMode = on
 		return nil
 	}
 }
+
+// LoggerOption is a config function allowing setting of the logger used by the client. Records
+// carry structured attributes such as operation, id, request_id and duration. Without this
+// option the client logs nothing.
+func LoggerOption(logger *slog.Logger) ClientOption {
+	return func(c *Client) error {
+		if logger == nil {
+			return errors.New("logger must not be nil")
+		}
+		c.logger = logger
+		return nil
+	}
+}
+
+// TracerProviderOption is a config function allowing setting of the OpenTelemetry tracer
+// provider. Each GraphQL operation gets a span named after the operation, and the trace
+// context is sent to the API in the request headers.
+func TracerProviderOption(provider trace.TracerProvider) ClientOption {
+	return func(c *Client) error {
+		if provider == nil {
+			return errors.New("tracer provider must not be nil")
+		}
+		c.tracerProvider = provider
+		return nil
+	}
+}
+
+// MeterProviderOption is a config function allowing setting of the OpenTelemetry meter
+// provider used to record the number, duration, errors and retries of GraphQL operations.
+func MeterProviderOption(provider metric.MeterProvider) ClientOption {
+	return func(c *Client) error {
+		if provider == nil {
+			return errors.New("meter provider must not be nil")
+		}
+		c.meterProvider = provider
+		return nil
+	}
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"io"
@@ -94,6 +95,23 @@ func getGraphQLInput[T any](r *http.Request) (*T, error) {
 	return &requestInput, err
 }
 
+// Returns the name of the GraphQL operation of the request. The body of the request is kept, so
+// it can still be read with getGraphQLInput.
+func getGraphQLOperation(r *http.Request) (string, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return "", err
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	request := new(graphql.Request)
+	if err := json.Unmarshal(body, request); err != nil {
+		return "", err
+	}
+
+	return request.OpName, nil
+}
+
 func testObjects(t *testing.T, obj1 any, obj2 any) bool {
 	if !cmp.Equal(obj1, obj2) {
 		t.Log(cmp.Diff(obj1, obj2))
@@ -0,0 +1,23 @@
+package client
+
+import (
+	"time"
+)
+
+// clock is the source of time for code that waits between requests, so that tests can control
+// the passing of time.
+type clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+// systemClock is the clock backed by the time package.
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
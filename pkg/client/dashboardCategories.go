@@ -0,0 +1,194 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+type DashboardCategoriesService service
+
+type DashboardCategoriesPaginator = Paginator[*OffsetPage[DashboardCategoryCount], DashboardCategoryCount]
+
+type DashboardCategoriesCommunicator interface {
+	Create(context.Context, string) (*DashboardCategory, error)
+	Update(context.Context, string, string) (*DashboardCategory, error)
+	Delete(context.Context, string) error
+	Search(context.Context, SearchDashboardCategoriesInput) (*SearchDashboardCategoriesResult, error)
+	SearchAll(context.Context, SearchDashboardCategoriesInput, *ListOptions) *DashboardCategoriesPaginator
+	ReadByName(context.Context, string) (*DashboardCategory, error)
+}
+
+func newDashboardCategoriesService(c *Client) *DashboardCategoriesService {
+	return &DashboardCategoriesService{c}
+}
+
+// Creates a new dashboard category with the given name.
+func (service *DashboardCategoriesService) Create(ctx context.Context, name string) (*DashboardCategory, error) {
+	service.client.logger.DebugContext(ctx, "create dashboard category request", "name", name)
+
+	resp, err := doMutate(ctx,
+		func(ctx context.Context) (*createDashboardCategoryResponse, error) {
+			return createDashboardCategory(ctx, service.client.gql, CreateCategoryInput{
+				Name: name,
+			})
+		},
+		func(resp *createDashboardCategoryResponse) error {
+			if !resp.CreateCategory.Success {
+				return mutateError("create dashboard category failed",
+					resp.CreateCategory.Code,
+					resp.CreateCategory.Message)
+			}
+			return nil
+		})
+
+	if err != nil {
+		return nil, err
+	}
+
+	category := resp.CreateCategory.Category
+	if category == nil {
+		return nil, ErrUnknown
+	}
+	service.client.logger.DebugContext(ctx, "create dashboard category success", "id", category.Id)
+
+	return category, nil
+}
+
+// Renames the dashboard category with the given id.
+func (service *DashboardCategoriesService) Update(ctx context.Context, id string, name string) (*DashboardCategory, error) {
+	service.client.logger.DebugContext(ctx, "update dashboard category request", "id", id)
+
+	resp, err := doMutate(ctx,
+		func(ctx context.Context) (*updateDashboardCategoryResponse, error) {
+			return updateDashboardCategory(ctx, service.client.gql, UpdateCategoryInput{
+				Id:   id,
+				Name: name,
+			})
+		},
+		func(resp *updateDashboardCategoryResponse) error {
+			if !resp.UpdateCategory.Success {
+				return mutateError("update dashboard category failed",
+					resp.UpdateCategory.Code,
+					resp.UpdateCategory.Message)
+			}
+			return nil
+		})
+
+	if err != nil {
+		return nil, err
+	}
+
+	category := resp.UpdateCategory.Category
+	if category == nil {
+		return nil, ErrUnknown
+	}
+	service.client.logger.DebugContext(ctx, "update dashboard category success", "id", id)
+
+	return category, nil
+}
+
+// Deletes the dashboard category with the given id.
+func (service *DashboardCategoriesService) Delete(ctx context.Context, id string) error {
+	service.client.logger.DebugContext(ctx, "delete dashboard category request", "id", id)
+
+	_, err := doMutate(ctx,
+		func(ctx context.Context) (*deleteDashboardCategoryResponse, error) {
+			return deleteDashboardCategory(ctx, service.client.gql, DeleteCategoryInput{
+				Id: id,
+			})
+		},
+		func(resp *deleteDashboardCategoryResponse) error {
+			if !resp.DeleteCategory.Success {
+				return mutateError("delete dashboard category failed",
+					resp.DeleteCategory.Code,
+					resp.DeleteCategory.Message)
+			}
+			return nil
+		})
+
+	if err != nil {
+		return err
+	}
+
+	service.client.logger.DebugContext(ctx, "delete dashboard category success", "id", id)
+
+	return nil
+}
+
+// Returns one page of the dashboard categories matching the search input, with the number of
+// dashboards in each category.
+func (service *DashboardCategoriesService) Search(ctx context.Context, input SearchDashboardCategoriesInput) (*SearchDashboardCategoriesResult, error) {
+	service.client.logger.DebugContext(ctx, "search dashboard categories request", "name", input.Name)
+
+	resp, err := searchDashboardCategories(ctx, service.client.gql, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SearchDashboardCategoriesResult{}
+	if resp.Dashboards != nil && resp.Dashboards.Categories != nil {
+		result = &resp.Dashboards.Categories.Search
+	}
+
+	service.client.logger.DebugContext(ctx, "search dashboard categories success",
+		"count", len(result.Categories), "total", result.TotalCategoriesCount)
+
+	return result, nil
+}
+
+// Returns a paginator over every dashboard category matching the search input. The pagination
+// of the input is replaced by the paging of the options.
+func (service *DashboardCategoriesService) SearchAll(ctx context.Context, input SearchDashboardCategoriesInput, opts *ListOptions) *DashboardCategoriesPaginator {
+	return NewPaginator(ctx,
+		func(ctx context.Context, paging *PagingInput) (*OffsetPage[DashboardCategoryCount], error) {
+			pagination, err := offsetPagination(paging)
+			if err != nil {
+				return nil, err
+			}
+
+			pageInput := input
+			pageInput.Pagination = pagination
+			result, err := service.Search(ctx, pageInput)
+			if err != nil {
+				return nil, err
+			}
+
+			return &OffsetPage[DashboardCategoryCount]{
+				Items:  result.Categories,
+				Offset: pagination.Offset,
+				Total:  result.TotalCategoriesCount,
+			}, nil
+		},
+		func(page *OffsetPage[DashboardCategoryCount]) []DashboardCategoryCount {
+			return page.Items
+		},
+		opts.paginatorOptions()...)
+}
+
+// Returns the dashboard category with the given name. Names are compared exactly. An error is
+// returned if no category or more than one category has the name.
+func (service *DashboardCategoriesService) ReadByName(ctx context.Context, name string) (*DashboardCategory, error) {
+	var matches []DashboardCategory
+	for category, err := range service.SearchAll(ctx, SearchDashboardCategoriesInput{Name: &name}, nil).Items() {
+		if err != nil {
+			return nil, err
+		}
+		if category.Category.Name == name {
+			matches = append(matches, category.Category)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("dashboard category %q: %w", name, ErrNotFound)
+	case 1:
+		return &matches[0], nil
+	default:
+		ids := make([]string, len(matches))
+		for i, match := range matches {
+			ids[i] = match.Id
+		}
+		return nil, fmt.Errorf("dashboard category %q is the name of %d categories: %v", name, len(matches), ids)
+	}
+}
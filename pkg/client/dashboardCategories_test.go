@@ -0,0 +1,240 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func mockDashboardCategories() []DashboardCategoryCount {
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	return []DashboardCategoryCount{
+		{Category: DashboardCategory{Id: "c-1", Name: "ops", Type: "CUSTOM", CreatedAt: createdAt, UpdatedAt: createdAt}, DashboardsCount: 3},
+		{Category: DashboardCategory{Id: "c-2", Name: "ops-team", Type: "CUSTOM", CreatedAt: createdAt, UpdatedAt: createdAt}, DashboardsCount: 1},
+		{Category: DashboardCategory{Id: "c-3", Name: "shared", Type: "CUSTOM", CreatedAt: createdAt, UpdatedAt: createdAt}},
+		{Category: DashboardCategory{Id: "c-4", Name: "shared", Type: "CUSTOM", CreatedAt: createdAt, UpdatedAt: createdAt}},
+	}
+}
+
+func sendDashboardCategories(t *testing.T, w http.ResponseWriter, categories []DashboardCategoryCount) {
+	sendGraphQLResponse(t, w, searchDashboardCategoriesResponse{
+		Dashboards: &searchDashboardCategoriesDashboardsDashboardQueries{
+			Categories: &searchDashboardCategoriesDashboardsDashboardQueriesCategoriesDashboardCategoryQueries{
+				Search: SearchDashboardCategoriesResult{
+					Categories:           categories,
+					TotalCategoriesCount: len(categories),
+				},
+			},
+		},
+	})
+}
+
+func TestService_CreateDashboardCategory(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	want := mockDashboardCategories()[0].Category
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__createDashboardCategoryInput](r)
+		if err != nil {
+			t.Errorf("Swo.CreateDashboardCategory error: %v", err)
+		}
+		if input := (CreateCategoryInput{Name: "ops"}); !testObjects(t, gqlInput.Input, input) {
+			t.Errorf("Request got = %+v, want = %+v", gqlInput.Input, input)
+		}
+
+		sendGraphQLResponse(t, w, createDashboardCategoryResponse{
+			CreateCategory: createDashboardCategoryCreateCategoryCreateCategoryResponse{
+				Success:  true,
+				Category: &want,
+			},
+		})
+	})
+
+	got, err := client.DashboardCategoriesService().Create(ctx, "ops")
+	if err != nil {
+		t.Fatalf("Swo.CreateDashboardCategory returned error: %v", err)
+	}
+	if !testObjects(t, got, &want) {
+		t.Errorf("Swo.CreateDashboardCategory returned %+v, wanted %+v", got, want)
+	}
+}
+
+func TestService_UpdateDashboardCategory(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	want := mockDashboardCategories()[0].Category
+	want.Name = "operations"
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__updateDashboardCategoryInput](r)
+		if err != nil {
+			t.Errorf("Swo.UpdateDashboardCategory error: %v", err)
+		}
+		if input := (UpdateCategoryInput{Id: "c-1", Name: "operations"}); !testObjects(t, gqlInput.Input, input) {
+			t.Errorf("Request got = %+v, want = %+v", gqlInput.Input, input)
+		}
+
+		sendGraphQLResponse(t, w, updateDashboardCategoryResponse{
+			UpdateCategory: updateDashboardCategoryUpdateCategoryUpdateCategoryResponse{
+				Success:  true,
+				Category: &want,
+			},
+		})
+	})
+
+	got, err := client.DashboardCategoriesService().Update(ctx, "c-1", "operations")
+	if err != nil {
+		t.Fatalf("Swo.UpdateDashboardCategory returned error: %v", err)
+	}
+	if !testObjects(t, got, &want) {
+		t.Errorf("Swo.UpdateDashboardCategory returned %+v, wanted %+v", got, want)
+	}
+}
+
+func TestService_DeleteDashboardCategory(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__deleteDashboardCategoryInput](r)
+		if err != nil {
+			t.Errorf("Swo.DeleteDashboardCategory error: %v", err)
+		}
+		if input := (DeleteCategoryInput{Id: "c-1"}); !testObjects(t, gqlInput.Input, input) {
+			t.Errorf("Request got = %+v, want = %+v", gqlInput.Input, input)
+		}
+
+		sendGraphQLResponse(t, w, deleteDashboardCategoryResponse{
+			DeleteCategory: deleteDashboardCategoryDeleteCategoryDeleteCategoryResponse{
+				Success: true,
+			},
+		})
+	})
+
+	if err := client.DashboardCategoriesService().Delete(ctx, "c-1"); err != nil {
+		t.Errorf("Swo.DeleteDashboardCategory returned error: %v", err)
+	}
+}
+
+func TestService_SearchDashboardCategories(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	input := SearchDashboardCategoriesInput{Name: Ptr("ops"), Pagination: &PaginationInput{Limit: 10}}
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__searchDashboardCategoriesInput](r)
+		if err != nil {
+			t.Errorf("Swo.SearchDashboardCategories error: %v", err)
+		}
+		if !testObjects(t, gqlInput.Inputs, input) {
+			t.Errorf("Request got = %+v, want = %+v", gqlInput.Inputs, input)
+		}
+
+		sendDashboardCategories(t, w, mockDashboardCategories()[:2])
+	})
+
+	got, err := client.DashboardCategoriesService().Search(ctx, input)
+	if err != nil {
+		t.Fatalf("Swo.SearchDashboardCategories returned error: %v", err)
+	}
+
+	want := &SearchDashboardCategoriesResult{Categories: mockDashboardCategories()[:2], TotalCategoriesCount: 2}
+	if !testObjects(t, got, want) {
+		t.Errorf("Swo.SearchDashboardCategories returned %+v, wanted %+v", got, want)
+	}
+}
+
+func TestService_ReadDashboardCategoryByName(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		sendDashboardCategories(t, w, mockDashboardCategories())
+	})
+
+	got, err := client.DashboardCategoriesService().ReadByName(ctx, "ops")
+	if err != nil {
+		t.Fatalf("Swo.ReadDashboardCategoryByName returned error: %v", err)
+	}
+	if want := &mockDashboardCategories()[0].Category; !testObjects(t, got, want) {
+		t.Errorf("Swo.ReadDashboardCategoryByName returned %+v, wanted %+v", got, want)
+	}
+
+	if _, err := client.DashboardCategoriesService().ReadByName(ctx, "missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Swo.ReadDashboardCategoryByName returned %v, wanted ErrNotFound", err)
+	}
+	if _, err := client.DashboardCategoriesService().ReadByName(ctx, "shared"); err == nil {
+		t.Error("Swo.ReadDashboardCategoryByName expected an error for an ambiguous name")
+	}
+}
+
+func TestService_DashboardCategoriesMutateError(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		sendGraphQLResponse(t, w, createDashboardCategoryResponse{
+			CreateCategory: createDashboardCategoryCreateCategoryCreateCategoryResponse{
+				Success: false,
+				Code:    "400",
+				Message: "category name already exists",
+			},
+		})
+	})
+
+	_, err := client.DashboardCategoriesService().Create(ctx, "ops")
+	if err == nil {
+		t.Error("Swo.DashboardCategoriesMutateError expected an error response")
+	}
+}
+
+func TestService_DashboardCategoriesMissingCategory(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		sendGraphQLResponse(t, w, []byte(`{"data":{
+			"createCategory":{"success":true,"category":null},
+			"updateCategory":{"success":true,"category":null}}}`))
+	})
+
+	if _, err := client.DashboardCategoriesService().Create(ctx, "ops"); !errors.Is(err, ErrUnknown) {
+		t.Errorf("Swo.CreateDashboardCategory returned %v, want ErrUnknown", err)
+	}
+	if _, err := client.DashboardCategoriesService().Update(ctx, "123", "ops"); !errors.Is(err, ErrUnknown) {
+		t.Errorf("Swo.UpdateDashboardCategory returned %v, want ErrUnknown", err)
+	}
+}
+
+func TestService_DashboardCategoriesServerErrors(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", httpErrorResponse)
+
+	_, err := client.DashboardCategoriesService().Create(ctx, "ops")
+	if err == nil {
+		t.Error("Swo.DashboardCategoriesServerErrors expected an error response")
+	}
+	_, err = client.DashboardCategoriesService().Update(ctx, "c-1", "ops")
+	if err == nil {
+		t.Error("Swo.DashboardCategoriesServerErrors expected an error response")
+	}
+	err = client.DashboardCategoriesService().Delete(ctx, "c-1")
+	if err == nil {
+		t.Error("Swo.DashboardCategoriesServerErrors expected an error response")
+	}
+	_, err = client.DashboardCategoriesService().Search(ctx, SearchDashboardCategoriesInput{})
+	if err == nil {
+		t.Error("Swo.DashboardCategoriesServerErrors expected an error response")
+	}
+	_, err = client.DashboardCategoriesService().ReadByName(ctx, "ops")
+	if err == nil {
+		t.Error("Swo.DashboardCategoriesServerErrors expected an error response")
+	}
+}
@@ -0,0 +1,210 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v2"
+)
+
+// DashboardDocumentFormat is an encoding of a DashboardDocument.
+type DashboardDocumentFormat string
+
+const (
+	DashboardDocumentJSON DashboardDocumentFormat = "json"
+	DashboardDocumentYAML DashboardDocumentFormat = "yaml"
+)
+
+// DashboardDocument is a dashboard without the ids, owner and timestamps assigned by the server,
+// which can be imported into another organization or region. The ids of the widgets only link
+// the widgets to their layout and are replaced when the document is imported.
+type DashboardDocument struct {
+	Name        string         `json:"name"`
+	Description *string        `json:"description,omitempty"`
+	IsPrivate   *bool          `json:"isPrivate,omitempty"`
+	Mode        *DashboardMode `json:"mode,omitempty"`
+	// The version of the dashboard configuration. Nil means version 1.
+	Version *int `json:"version,omitempty"`
+	// The name of the category the dashboard is filed in.
+	Category *string                   `json:"category,omitempty"`
+	Widgets  []DashboardDocumentWidget `json:"widgets"`
+	Layout   []LayoutInput             `json:"layout"`
+}
+
+type DashboardDocumentWidget struct {
+	Id         string  `json:"id"`
+	Type       string  `json:"type"`
+	Title      *string `json:"title,omitempty"`
+	Properties *any    `json:"properties,omitempty"`
+}
+
+// Document returns the portable document of the dashboard.
+func (r *ReadDashboardResult) Document() *DashboardDocument {
+	doc := &DashboardDocument{
+		Name:        r.Name,
+		Description: r.Description,
+		IsPrivate:   r.IsPrivate,
+		Mode:        r.Mode,
+		Version:     r.Version,
+		Widgets:     make([]DashboardDocumentWidget, len(r.Widgets)),
+		Layout:      make([]LayoutInput, len(r.Layout)),
+	}
+	if r.Category != nil {
+		doc.Category = &r.Category.Name
+	}
+	for i, widget := range r.Widgets {
+		doc.Widgets[i] = DashboardDocumentWidget{
+			Id:         widget.Id,
+			Type:       widget.Type,
+			Title:      widget.Title,
+			Properties: widget.Properties,
+		}
+	}
+	for i, entry := range r.Layout {
+		doc.Layout[i] = LayoutInput{
+			Id:     entry.Id,
+			X:      entry.X,
+			Y:      entry.Y,
+			Width:  entry.Width,
+			Height: entry.Height,
+		}
+	}
+	return doc
+}
+
+// Marshal encodes the document in the given format.
+func (d *DashboardDocument) Marshal(format DashboardDocumentFormat) ([]byte, error) {
+	switch format {
+	case DashboardDocumentJSON:
+		return json.MarshalIndent(d, "", "  ")
+	case DashboardDocumentYAML:
+		// The document is converted to generic maps first, so that the YAML keys and omitted
+		// fields are the same as in JSON.
+		data, err := json.Marshal(d)
+		if err != nil {
+			return nil, err
+		}
+		var generic yaml.MapSlice
+		if err := yaml.Unmarshal(data, &generic); err != nil {
+			return nil, err
+		}
+		return yaml.Marshal(generic)
+	default:
+		return nil, fmt.Errorf("unknown dashboard document format %q", format)
+	}
+}
+
+// UnmarshalDashboardDocument decodes a document in the given format.
+func UnmarshalDashboardDocument(data []byte, format DashboardDocumentFormat) (*DashboardDocument, error) {
+	switch format {
+	case DashboardDocumentJSON:
+	case DashboardDocumentYAML:
+		var generic any
+		if err := yaml.Unmarshal(data, &generic); err != nil {
+			return nil, fmt.Errorf("decode dashboard document: %w", err)
+		}
+		converted, err := yamlToJSONValue(generic)
+		if err != nil {
+			return nil, fmt.Errorf("decode dashboard document: %w", err)
+		}
+		if data, err = json.Marshal(converted); err != nil {
+			return nil, fmt.Errorf("decode dashboard document: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unknown dashboard document format %q", format)
+	}
+
+	var doc DashboardDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("decode dashboard document: %w", err)
+	}
+	return &doc, nil
+}
+
+// yamlToJSONValue converts the maps decoded by the YAML package, whose keys can be of any type,
+// to maps with string keys that can be encoded as JSON.
+func yamlToJSONValue(value any) (any, error) {
+	switch v := value.(type) {
+	case map[any]any:
+		m := make(map[string]any, len(v))
+		for key, item := range v {
+			name, ok := key.(string)
+			if !ok {
+				return nil, fmt.Errorf("map key %v is not a string", key)
+			}
+			converted, err := yamlToJSONValue(item)
+			if err != nil {
+				return nil, err
+			}
+			m[name] = converted
+		}
+		return m, nil
+	case []any:
+		s := make([]any, len(v))
+		for i, item := range v {
+			converted, err := yamlToJSONValue(item)
+			if err != nil {
+				return nil, err
+			}
+			s[i] = converted
+		}
+		return s, nil
+	default:
+		return v, nil
+	}
+}
+
+// remapIds returns the widgets and layout of the document with a new id for every widget. Layout
+// entries follow the id of their widget.
+func (d *DashboardDocument) remapIds() ([]WidgetInput, []LayoutInput, error) {
+	ids := make(map[string]string, len(d.Widgets))
+	widgets := make([]WidgetInput, len(d.Widgets))
+	for i, widget := range d.Widgets {
+		id := uuid.NewString()
+		ids[widget.Id] = id
+		widgets[i] = WidgetInput{
+			Id:         id,
+			Title:      widget.Title,
+			Type:       widget.Type,
+			Properties: widget.Properties,
+		}
+	}
+
+	layout := make([]LayoutInput, len(d.Layout))
+	for i, entry := range d.Layout {
+		entry.Id = ids[entry.Id]
+		layout[i] = entry
+	}
+
+	// The layout is checked against the original ids, which are the ones the caller knows.
+	original := make([]WidgetInput, len(d.Widgets))
+	for i, widget := range d.Widgets {
+		original[i] = WidgetInput{Id: widget.Id}
+	}
+	if err := joinValidationErrors(ValidateDashboardLayout(original, d.Layout)); err != nil {
+		return nil, nil, fmt.Errorf("dashboard document %q: %w", d.Name, err)
+	}
+
+	return widgets, layout, nil
+}
+
+// CreateInput returns the input to create the dashboard of the document, with new widget ids.
+// The category of the document is not resolved, so CategoryId is nil. The returned error wraps
+// ErrValidation if the layout of the document is invalid.
+func (d *DashboardDocument) CreateInput() (CreateDashboardInput, error) {
+	widgets, layout, err := d.remapIds()
+	if err != nil {
+		return CreateDashboardInput{}, err
+	}
+
+	return CreateDashboardInput{
+		Name:        d.Name,
+		Description: d.Description,
+		IsPrivate:   d.IsPrivate,
+		Mode:        d.Mode,
+		Version:     d.Version,
+		Widgets:     widgets,
+		Layout:      layout,
+	}, nil
+}
@@ -0,0 +1,129 @@
+package client
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func mockDashboardDocument() *DashboardDocument {
+	var properties any = map[string]any{
+		"title": "Kpi Widget",
+		"unit":  "ms",
+		"dataSource": map[string]any{
+			"type":       "kpi",
+			"properties": map[string]any{"series": []any{map[string]any{"metric": "synthetics.https.response.time", "limit": map[string]any{"value": 50.0}}}},
+		},
+	}
+	return &DashboardDocument{
+		Name:        dashboardsMockData.fieldName,
+		Description: Ptr("latency"),
+		IsPrivate:   Ptr(true),
+		Mode:        Ptr(DashboardModeAnalysis),
+		Version:     Ptr(2),
+		Category:    Ptr("ops"),
+		Widgets: []DashboardDocumentWidget{
+			{Id: "w-1", Type: WidgetTypeKpi, Properties: &properties},
+			{Id: "w-2", Type: WidgetTypeText, Title: Ptr("notes")},
+		},
+		Layout: []LayoutInput{
+			{Id: "w-2", X: 4, Y: 0, Width: 8, Height: 2},
+			{Id: "w-1", X: 0, Y: 0, Width: 4, Height: 2},
+		},
+	}
+}
+
+func TestReadDashboardResult_Document(t *testing.T) {
+	doc := mockDashboardDocument()
+	read := ReadDashboardResult{
+		Id:          "123",
+		Version:     doc.Version,
+		Name:        doc.Name,
+		Description: doc.Description,
+		OwnerId:     &dashboardsMockData.fieldOwnerId,
+		CreatedAt:   dashboardsMockData.fieldUpdatedAt,
+		UpdatedAt:   dashboardsMockData.fieldUpdatedAt,
+		IsPrivate:   doc.IsPrivate,
+		Mode:        doc.Mode,
+		Category: &getDashboardByIdDashboardsDashboardQueriesByIdOrSystemReferenceDashboardCategory{
+			Id:   dashboardsMockData.fieldCategoryId,
+			Name: "ops",
+		},
+		Widgets: []ReadDashboardWidget{
+			{Id: "w-1", Type: WidgetTypeKpi, Properties: doc.Widgets[0].Properties},
+			{Id: "w-2", Type: WidgetTypeText, Title: Ptr("notes")},
+		},
+		Layout: []ReadDashboardLayout{
+			{Id: "w-2", X: 4, Y: 0, Width: 8, Height: 2},
+			{Id: "w-1", X: 0, Y: 0, Width: 4, Height: 2},
+		},
+	}
+
+	if got := read.Document(); !testObjects(t, got, doc) {
+		t.Errorf("ReadDashboardResult.Document returned %+v, want %+v", got, doc)
+	}
+}
+
+func TestDashboardDocument_Marshal(t *testing.T) {
+	doc := mockDashboardDocument()
+
+	for _, format := range []DashboardDocumentFormat{DashboardDocumentJSON, DashboardDocumentYAML} {
+		t.Run(string(format), func(t *testing.T) {
+			data, err := doc.Marshal(format)
+			if err != nil {
+				t.Fatalf("DashboardDocument.Marshal returned error: %v", err)
+			}
+
+			got, err := UnmarshalDashboardDocument(data, format)
+			if err != nil {
+				t.Fatalf("UnmarshalDashboardDocument returned error: %v", err)
+			}
+			if !testObjects(t, got, doc) {
+				t.Errorf("UnmarshalDashboardDocument returned %+v, want %+v", got, doc)
+			}
+		})
+	}
+
+	data, err := doc.Marshal(DashboardDocumentYAML)
+	if err != nil {
+		t.Fatalf("DashboardDocument.Marshal returned error: %v", err)
+	}
+	if want := "name: swo-client-go - title\ndescription: latency\n"; !strings.HasPrefix(string(data), want) {
+		t.Errorf("DashboardDocument.Marshal returned %q, want prefix %q", data, want)
+	}
+
+	if _, err := doc.Marshal("xml"); err == nil {
+		t.Error("DashboardDocument.Marshal expected an error for an unknown format")
+	}
+	if _, err := UnmarshalDashboardDocument([]byte("name: [unclosed"), DashboardDocumentYAML); err == nil {
+		t.Error("UnmarshalDashboardDocument expected an error for invalid YAML")
+	}
+}
+
+func TestDashboardDocument_CreateInput(t *testing.T) {
+	doc := mockDashboardDocument()
+
+	got, err := doc.CreateInput()
+	if err != nil {
+		t.Fatalf("DashboardDocument.CreateInput returned error: %v", err)
+	}
+
+	if got.Name != doc.Name || got.CategoryId != nil || !testObjects(t, got.Description, doc.Description) ||
+		!testObjects(t, got.Version, doc.Version) || !testObjects(t, got.Mode, doc.Mode) {
+		t.Errorf("DashboardDocument.CreateInput returned %+v", got)
+	}
+	if got.Widgets[0].Id == "w-1" || got.Widgets[0].Id == got.Widgets[1].Id {
+		t.Errorf("DashboardDocument.CreateInput did not assign new widget ids: %+v", got.Widgets)
+	}
+	if got.Layout[0].Id != got.Widgets[1].Id || got.Layout[1].Id != got.Widgets[0].Id {
+		t.Errorf("DashboardDocument.CreateInput layout %+v does not follow widgets %+v", got.Layout, got.Widgets)
+	}
+	if doc.Widgets[0].Id != "w-1" || doc.Layout[0].Id != "w-2" {
+		t.Error("DashboardDocument.CreateInput changed the ids of the document")
+	}
+
+	doc.Layout = append(doc.Layout, LayoutInput{Id: "w-3", X: 0, Y: 2, Width: 4, Height: 2})
+	if _, err := doc.CreateInput(); !errors.Is(err, ErrValidation) {
+		t.Errorf("DashboardDocument.CreateInput returned %v, want ErrValidation", err)
+	}
+}
@@ -0,0 +1,148 @@
+package client
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// The number of columns of the dashboard grid.
+const DashboardGridColumns = 12
+
+// DashboardLayoutBuilder builds the widgets and layout of a dashboard from typed widget
+// properties. Widgets are given new ids and packed left to right into rows of the grid, and a
+// widget that does not fit the rest of a row starts the next row. For example:
+//
+//	widgets, layout, err := NewDashboardLayout().
+//		Add(&KpiWidget{Title: "Latency", DataSource: KpiDataSource(MetricSeries("http.latency", "AVG"))}, 4, 2).
+//		Add(&TimeSeriesWidget{...}, 8, 2).
+//		Build()
+//
+// Errors found while adding widgets are reported by Build.
+type DashboardLayoutBuilder struct {
+	widgets   []WidgetInput
+	layout    []LayoutInput
+	x         int
+	y         int
+	rowHeight int
+	errs      []ValidationError
+}
+
+// NewDashboardLayout returns an empty layout builder.
+func NewDashboardLayout() *DashboardLayoutBuilder {
+	return &DashboardLayoutBuilder{}
+}
+
+// Add places a widget with the given properties and size in grid cells after the previous
+// widget.
+func (b *DashboardLayoutBuilder) Add(properties WidgetProperties, width int, height int) *DashboardLayoutBuilder {
+	i := len(b.widgets)
+	for _, err := range properties.Validate() {
+		err.Path = strings.Replace(err.Path, "$.", fmt.Sprintf("$.widgets[%d].", i), 1)
+		b.errs = append(b.errs, err)
+	}
+
+	if b.x+width > DashboardGridColumns {
+		b.NewRow()
+	}
+
+	var value any = properties
+	id := uuid.NewString()
+	b.widgets = append(b.widgets, WidgetInput{
+		Id:         id,
+		Type:       properties.WidgetType(),
+		Properties: &value,
+	})
+	b.layout = append(b.layout, LayoutInput{
+		Id:     id,
+		X:      b.x,
+		Y:      b.y,
+		Width:  width,
+		Height: height,
+	})
+
+	b.x += width
+	b.rowHeight = max(b.rowHeight, height)
+	return b
+}
+
+// NewRow starts a new row below the tallest widget of the current row. It does nothing if the
+// current row is empty.
+func (b *DashboardLayoutBuilder) NewRow() *DashboardLayoutBuilder {
+	if b.x > 0 {
+		b.y += b.rowHeight
+		b.x = 0
+		b.rowHeight = 0
+	}
+	return b
+}
+
+// Build returns the widgets and layout of the dashboard, e.g. for CreateDashboardInput. The
+// returned error wraps ErrValidation if a widget or the layout is invalid.
+func (b *DashboardLayoutBuilder) Build() ([]WidgetInput, []LayoutInput, error) {
+	errs := slices.Concat(b.errs, ValidateDashboardLayout(b.widgets, b.layout))
+	if err := joinValidationErrors(errs); err != nil {
+		return nil, nil, fmt.Errorf("dashboard layout: %w", err)
+	}
+
+	return b.widgets, b.layout, nil
+}
+
+// ValidateDashboardLayout checks that the widget ids are unique and that every layout entry
+// references a widget, fits the grid and does not overlap another entry. It returns nil when no
+// errors are found.
+func ValidateDashboardLayout(widgets []WidgetInput, layout []LayoutInput) []ValidationError {
+	var errs []ValidationError
+	addError := func(path string, format string, args ...any) {
+		errs = append(errs, ValidationError{Path: path, Message: fmt.Sprintf(format, args...)})
+	}
+
+	widgetIds := make(map[string]int, len(widgets))
+	for i, widget := range widgets {
+		path := fmt.Sprintf("$.widgets[%d].id", i)
+		if widget.Id == "" {
+			addError(path, "is required")
+		} else if j, ok := widgetIds[widget.Id]; ok {
+			addError(path, "duplicates the id of widget %d", j)
+		} else {
+			widgetIds[widget.Id] = i
+		}
+	}
+
+	layoutIds := make(map[string]int, len(layout))
+	for i, entry := range layout {
+		path := fmt.Sprintf("$.layout[%d]", i)
+		if _, ok := widgetIds[entry.Id]; !ok {
+			addError(path+".id", "references missing widget %q", entry.Id)
+		} else if j, ok := layoutIds[entry.Id]; ok {
+			addError(path+".id", "duplicates the widget of layout entry %d", j)
+		} else {
+			layoutIds[entry.Id] = i
+		}
+
+		if entry.X < 0 || entry.Y < 0 {
+			addError(path, "position %d,%d is outside the grid", entry.X, entry.Y)
+		}
+		if entry.Width < 1 || entry.X+entry.Width > DashboardGridColumns {
+			addError(path+".width", "%d at column %d does not fit the %d columns of the grid", entry.Width, entry.X, DashboardGridColumns)
+		}
+		if entry.Height < 1 {
+			addError(path+".height", "must be at least 1")
+		}
+
+		for j, other := range layout[:i] {
+			if layoutEntriesOverlap(entry, other) {
+				addError(path, "overlaps layout entry %d", j)
+			}
+		}
+	}
+
+	return errs
+}
+
+func layoutEntriesOverlap(a LayoutInput, b LayoutInput) bool {
+	return a.X < b.X+b.Width && b.X < a.X+a.Width &&
+		a.Y < b.Y+b.Height && b.Y < a.Y+a.Height
+}
@@ -0,0 +1,94 @@
+package client
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDashboardLayoutBuilder_Build(t *testing.T) {
+	text := &TextWidget{Text: "## Synthetics"}
+	kpi := &KpiWidget{Title: "Latency", DataSource: KpiDataSource(MetricSeries("synthetics.https.response.time", "AVG"))}
+
+	widgets, layout, err := NewDashboardLayout().
+		Add(text, 12, 1).
+		Add(kpi, 4, 2).
+		Add(kpi, 4, 3).
+		Add(kpi, 6, 2).
+		NewRow().
+		NewRow().
+		Add(text, 2, 1).
+		Build()
+	if err != nil {
+		t.Fatalf("DashboardLayoutBuilder.Build returned error: %v", err)
+	}
+
+	if len(widgets) != 5 {
+		t.Fatalf("DashboardLayoutBuilder.Build returned %d widgets, want 5", len(widgets))
+	}
+	for i, widget := range widgets {
+		if widget.Id == "" || widget.Id != layout[i].Id {
+			t.Errorf("DashboardLayoutBuilder.Build widget %d has id %q and layout id %q", i, widget.Id, layout[i].Id)
+		}
+	}
+	if widgets[1].Type != WidgetTypeKpi || *widgets[1].Properties != any(kpi) {
+		t.Errorf("DashboardLayoutBuilder.Build widget 1 = %+v, want Kpi properties", widgets[1])
+	}
+
+	type cell struct{ X, Y, Width, Height int }
+	var got []cell
+	for _, entry := range layout {
+		got = append(got, cell{entry.X, entry.Y, entry.Width, entry.Height})
+	}
+	want := []cell{
+		{0, 0, 12, 1},
+		{0, 1, 4, 2},
+		{4, 1, 4, 3},
+		{0, 4, 6, 2},
+		{0, 6, 2, 1},
+	}
+	if !testObjects(t, got, want) {
+		t.Errorf("DashboardLayoutBuilder.Build layout = %+v, want %+v", got, want)
+	}
+}
+
+func TestDashboardLayoutBuilder_BuildErrors(t *testing.T) {
+	_, _, err := NewDashboardLayout().
+		Add(&TextWidget{Text: "ok"}, 2, 1).
+		Add(&TextWidget{}, 13, 0).
+		Build()
+
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("DashboardLayoutBuilder.Build returned %v, want ErrValidation", err)
+	}
+
+	want := "dashboard layout: validation failed: $.widgets[1].properties.text: is required\n" +
+		"$.layout[1].width: 13 at column 0 does not fit the 12 columns of the grid\n" +
+		"$.layout[1].height: must be at least 1"
+	if err.Error() != want {
+		t.Errorf("DashboardLayoutBuilder.Build returned %q, want %q", err, want)
+	}
+}
+
+func TestValidateDashboardLayout(t *testing.T) {
+	widgets := []WidgetInput{{Id: "a"}, {Id: "b"}, {Id: "a"}, {}}
+	layout := []LayoutInput{
+		{Id: "a", X: 0, Y: 0, Width: 4, Height: 2},
+		{Id: "b", X: 2, Y: 1, Width: 4, Height: 2},
+		{Id: "c", X: 4, Y: 0, Width: 4, Height: 2},
+		{Id: "a", X: -1, Y: 4, Width: 2, Height: 1},
+	}
+
+	got := ValidateDashboardLayout(widgets, layout)
+	want := []ValidationError{
+		{Path: "$.widgets[2].id", Message: "duplicates the id of widget 0"},
+		{Path: "$.widgets[3].id", Message: "is required"},
+		{Path: "$.layout[1]", Message: "overlaps layout entry 0"},
+		{Path: "$.layout[2].id", Message: `references missing widget "c"`},
+		{Path: "$.layout[2]", Message: "overlaps layout entry 1"},
+		{Path: "$.layout[3].id", Message: "duplicates the widget of layout entry 0"},
+		{Path: "$.layout[3]", Message: "position -1,4 is outside the grid"},
+	}
+	if !testObjects(t, got, want) {
+		t.Errorf("ValidateDashboardLayout returned %v, want %v", got, want)
+	}
+}
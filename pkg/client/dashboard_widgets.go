@@ -0,0 +1,277 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// The widget types that have typed properties.
+const (
+	WidgetTypeTimeSeries   = "TimeSeries"
+	WidgetTypeKpi          = "Kpi"
+	WidgetTypeProportional = "Proportional"
+	WidgetTypeTable        = "Table"
+	WidgetTypeText         = "Text"
+)
+
+// The data source type expected by each widget type that charts series.
+var widgetDataSourceTypes = map[string]string{
+	WidgetTypeTimeSeries:   "timeSeries",
+	WidgetTypeKpi:          "kpi",
+	WidgetTypeProportional: "proportional",
+	WidgetTypeTable:        "table",
+}
+
+// WidgetProperties is implemented by the typed properties of each widget type. The properties
+// marshal to the JSON expected by the properties field of a dashboard widget.
+type WidgetProperties interface {
+	// WidgetType returns the widget type the properties belong to, e.g. TimeSeries.
+	WidgetType() string
+	// Validate checks the properties for missing and malformed fields. It returns nil when no
+	// errors are found.
+	Validate() []ValidationError
+}
+
+// WidgetFormatOptions sets how the values of a series or chart are displayed.
+type WidgetFormatOptions struct {
+	Unit        *string `json:"unit,omitempty"`
+	Precision   *int    `json:"precision,omitempty"`
+	MinUnitSize *int    `json:"minUnitSize,omitempty"`
+}
+
+// WidgetSeriesLimit limits a grouped series to the top or bottom groups.
+type WidgetSeriesLimit struct {
+	IsAscending bool `json:"isAscending"`
+	Value       int  `json:"value"`
+}
+
+// WidgetSeries is a metric charted by a widget.
+type WidgetSeries struct {
+	Type                string               `json:"type"`
+	Metric              string               `json:"metric"`
+	AggregationFunction string               `json:"aggregationFunction"`
+	GroupBy             []string             `json:"groupBy"`
+	BucketGrouping      []string             `json:"bucketGrouping"`
+	Limit               *WidgetSeriesLimit   `json:"limit,omitempty"`
+	FormatOptions       *WidgetFormatOptions `json:"formatOptions,omitempty"`
+}
+
+// MetricSeries returns a series of the metric aggregated with the given function, e.g. AVG,
+// grouped by the given tags.
+func MetricSeries(metric string, aggregationFunction string, groupBy ...string) WidgetSeries {
+	if groupBy == nil {
+		groupBy = []string{}
+	}
+	return WidgetSeries{
+		Type:                "metric",
+		Metric:              metric,
+		AggregationFunction: aggregationFunction,
+		GroupBy:             groupBy,
+		BucketGrouping:      []string{},
+	}
+}
+
+// WidgetDataSource is the data charted by a widget.
+type WidgetDataSource struct {
+	Type       string                     `json:"type"`
+	Properties WidgetDataSourceProperties `json:"properties"`
+}
+
+type WidgetDataSourceProperties struct {
+	Series []WidgetSeries `json:"series"`
+	// Kpi widgets only.
+	IncludePercentageChange *bool `json:"includePercentageChange,omitempty"`
+	IsHigherBetter          *bool `json:"isHigherBetter,omitempty"`
+}
+
+// TimeSeriesDataSource returns the data source of a TimeSeries widget.
+func TimeSeriesDataSource(series ...WidgetSeries) WidgetDataSource {
+	return newWidgetDataSource(WidgetTypeTimeSeries, series)
+}
+
+// KpiDataSource returns the data source of a Kpi widget.
+func KpiDataSource(series ...WidgetSeries) WidgetDataSource {
+	return newWidgetDataSource(WidgetTypeKpi, series)
+}
+
+// ProportionalDataSource returns the data source of a Proportional widget.
+func ProportionalDataSource(series ...WidgetSeries) WidgetDataSource {
+	return newWidgetDataSource(WidgetTypeProportional, series)
+}
+
+// TableDataSource returns the data source of a Table widget.
+func TableDataSource(series ...WidgetSeries) WidgetDataSource {
+	return newWidgetDataSource(WidgetTypeTable, series)
+}
+
+func newWidgetDataSource(widgetType string, series []WidgetSeries) WidgetDataSource {
+	return WidgetDataSource{
+		Type:       widgetDataSourceTypes[widgetType],
+		Properties: WidgetDataSourceProperties{Series: series},
+	}
+}
+
+// WidgetYAxisFormatOverrides changes the format of the y-axis of a chart.
+type WidgetYAxisFormatOverrides struct {
+	ConversionFactor *float64 `json:"conversionFactor,omitempty"`
+	Precision        *int     `json:"precision,omitempty"`
+}
+
+// TimeSeriesChart sets how a TimeSeries widget draws its series.
+type TimeSeriesChart struct {
+	// The chart type, e.g. LineChart.
+	Type                 string                      `json:"type"`
+	Max                  *string                     `json:"max,omitempty"`
+	ShowLegend           *bool                       `json:"showLegend,omitempty"`
+	YAxisLabel           *string                     `json:"yAxisLabel,omitempty"`
+	FormatOptions        *WidgetFormatOptions        `json:"formatOptions,omitempty"`
+	YAxisFormatOverrides *WidgetYAxisFormatOverrides `json:"yAxisFormatOverrides,omitempty"`
+}
+
+// TimeSeriesWidget charts series over time.
+type TimeSeriesWidget struct {
+	Title      string           `json:"title"`
+	Subtitle   *string          `json:"subtitle,omitempty"`
+	Chart      TimeSeriesChart  `json:"chart"`
+	DataSource WidgetDataSource `json:"dataSource"`
+}
+
+func (w *TimeSeriesWidget) WidgetType() string { return WidgetTypeTimeSeries }
+
+func (w *TimeSeriesWidget) Validate() []ValidationError {
+	errs := appendRequired(nil, "$.properties.chart.type", w.Chart.Type)
+	return appendWidgetDataSource(errs, w.WidgetType(), w.DataSource)
+}
+
+// KpiWidget shows the current value of a series.
+type KpiWidget struct {
+	Title      string           `json:"title"`
+	Subtitle   *string          `json:"subtitle,omitempty"`
+	Unit       *string          `json:"unit,omitempty"`
+	LinkLabel  *string          `json:"linkLabel,omitempty"`
+	LinkUrl    *string          `json:"linkUrl,omitempty"`
+	DataSource WidgetDataSource `json:"dataSource"`
+}
+
+func (w *KpiWidget) WidgetType() string { return WidgetTypeKpi }
+
+func (w *KpiWidget) Validate() []ValidationError {
+	errs := appendWidgetDataSource(nil, w.WidgetType(), w.DataSource)
+	if w.LinkUrl != nil {
+		errs = appendURL(errs, "$.properties.linkUrl", *w.LinkUrl)
+	}
+	return errs
+}
+
+// ProportionalChart sets how a Proportional widget draws its series.
+type ProportionalChart struct {
+	// The chart type, e.g. DonutChart or HorizontalBarChart.
+	Type       string  `json:"type"`
+	ShowLegend *bool   `json:"showLegend,omitempty"`
+	Unit       *string `json:"unit,omitempty"`
+}
+
+// ProportionalWidget charts the share of each group of a series.
+type ProportionalWidget struct {
+	Title      string            `json:"title"`
+	Subtitle   *string           `json:"subtitle,omitempty"`
+	Chart      ProportionalChart `json:"chart"`
+	DataSource WidgetDataSource  `json:"dataSource"`
+}
+
+func (w *ProportionalWidget) WidgetType() string { return WidgetTypeProportional }
+
+func (w *ProportionalWidget) Validate() []ValidationError {
+	errs := appendRequired(nil, "$.properties.chart.type", w.Chart.Type)
+	return appendWidgetDataSource(errs, w.WidgetType(), w.DataSource)
+}
+
+// TableWidget lists the groups of its series in a table.
+type TableWidget struct {
+	Title      string           `json:"title"`
+	Subtitle   *string          `json:"subtitle,omitempty"`
+	DataSource WidgetDataSource `json:"dataSource"`
+}
+
+func (w *TableWidget) WidgetType() string { return WidgetTypeTable }
+
+func (w *TableWidget) Validate() []ValidationError {
+	return appendWidgetDataSource(nil, w.WidgetType(), w.DataSource)
+}
+
+// TextWidget shows markdown text.
+type TextWidget struct {
+	Title *string `json:"title,omitempty"`
+	Text  string  `json:"text"`
+}
+
+func (w *TextWidget) WidgetType() string { return WidgetTypeText }
+
+func (w *TextWidget) Validate() []ValidationError {
+	return appendRequired(nil, "$.properties.text", w.Text)
+}
+
+// appendWidgetDataSource adds the errors of a data source that does not match the widget type or
+// has incomplete series.
+func appendWidgetDataSource(errs []ValidationError, widgetType string, dataSource WidgetDataSource) []ValidationError {
+	if want := widgetDataSourceTypes[widgetType]; dataSource.Type != want {
+		errs = append(errs, ValidationError{Path: "$.properties.dataSource.type", Message: fmt.Sprintf("%q is not %q", dataSource.Type, want)})
+	}
+
+	series := dataSource.Properties.Series
+	if len(series) == 0 {
+		errs = append(errs, ValidationError{Path: "$.properties.dataSource.properties.series", Message: "must contain at least one series"})
+	}
+	for i, s := range series {
+		path := fmt.Sprintf("$.properties.dataSource.properties.series[%d]", i)
+		errs = appendRequired(errs, path+".metric", s.Metric)
+		errs = appendRequired(errs, path+".aggregationFunction", s.AggregationFunction)
+	}
+	return errs
+}
+
+// newWidgetProperties returns empty typed properties for the widget type.
+func newWidgetProperties(widgetType string) (WidgetProperties, error) {
+	switch widgetType {
+	case WidgetTypeTimeSeries:
+		return &TimeSeriesWidget{}, nil
+	case WidgetTypeKpi:
+		return &KpiWidget{}, nil
+	case WidgetTypeProportional:
+		return &ProportionalWidget{}, nil
+	case WidgetTypeTable:
+		return &TableWidget{}, nil
+	case WidgetTypeText:
+		return &TextWidget{}, nil
+	default:
+		return nil, fmt.Errorf("widget type %q has no typed properties", widgetType)
+	}
+}
+
+// DecodeWidgetProperties converts the raw properties of a widget to the typed properties of the
+// widget type.
+func DecodeWidgetProperties(widgetType string, properties any) (WidgetProperties, error) {
+	typed, err := newWidgetProperties(widgetType)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(properties)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, typed); err != nil {
+		return nil, fmt.Errorf("decode %s widget properties: %w", widgetType, err)
+	}
+
+	return typed, nil
+}
+
+// TypedProperties decodes the properties of the widget into the properties struct of its type,
+// e.g. *KpiWidget for Kpi widgets.
+func (w *ReadDashboardWidget) TypedProperties() (WidgetProperties, error) {
+	if w.Properties == nil {
+		return nil, fmt.Errorf("widget %s has no properties", w.Id)
+	}
+	return DecodeWidgetProperties(w.Type, *w.Properties)
+}
@@ -0,0 +1,127 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestWidgetProperties_Validate(t *testing.T) {
+	series := MetricSeries("synthetics.https.response.time", "AVG", "probe.region")
+
+	tests := []struct {
+		name       string
+		properties WidgetProperties
+		want       []ValidationError
+	}{
+		{
+			name:       "valid time series",
+			properties: &TimeSeriesWidget{Title: "latency", Chart: TimeSeriesChart{Type: "LineChart"}, DataSource: TimeSeriesDataSource(series)},
+		},
+		{
+			name:       "time series without chart and series",
+			properties: &TimeSeriesWidget{DataSource: TimeSeriesDataSource()},
+			want: []ValidationError{
+				{Path: "$.properties.chart.type", Message: "is required"},
+				{Path: "$.properties.dataSource.properties.series", Message: "must contain at least one series"},
+			},
+		},
+		{
+			name:       "kpi with another data source",
+			properties: &KpiWidget{DataSource: TableDataSource(series), LinkUrl: Ptr("www.solarwinds.com")},
+			want: []ValidationError{
+				{Path: "$.properties.dataSource.type", Message: `"table" is not "kpi"`},
+				{Path: "$.properties.linkUrl", Message: `"www.solarwinds.com" is not an http or https URL`},
+			},
+		},
+		{
+			name:       "proportional series",
+			properties: &ProportionalWidget{Chart: ProportionalChart{Type: "DonutChart"}, DataSource: ProportionalDataSource(WidgetSeries{Type: "metric"})},
+			want: []ValidationError{
+				{Path: "$.properties.dataSource.properties.series[0].metric", Message: "is required"},
+				{Path: "$.properties.dataSource.properties.series[0].aggregationFunction", Message: "is required"},
+			},
+		},
+		{
+			name:       "valid table",
+			properties: &TableWidget{DataSource: TableDataSource(series)},
+		},
+		{
+			name:       "text",
+			properties: &TextWidget{},
+			want:       []ValidationError{{Path: "$.properties.text", Message: "is required"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.properties.Validate()
+			if !testObjects(t, got, tt.want) {
+				t.Errorf("%T.Validate() = %v, want %v", tt.properties, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWidgetProperties_Marshal(t *testing.T) {
+	series := MetricSeries("synthetics.https.response.time", "AVG")
+	series.Limit = &WidgetSeriesLimit{Value: 50}
+
+	got, err := json.Marshal(&KpiWidget{
+		Title:      "Kpi Widget",
+		Unit:       Ptr("ms"),
+		DataSource: KpiDataSource(series),
+	})
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+
+	want := `{"title":"Kpi Widget","unit":"ms","dataSource":{"type":"kpi","properties":{"series":[` +
+		`{"type":"metric","metric":"synthetics.https.response.time","aggregationFunction":"AVG","groupBy":[],"bucketGrouping":[],` +
+		`"limit":{"isAscending":false,"value":50}}]}}}`
+	if string(got) != want {
+		t.Errorf("json.Marshal returned %s, want %s", got, want)
+	}
+}
+
+func TestReadDashboardWidget_TypedProperties(t *testing.T) {
+	var properties any = map[string]any{
+		"title": "TimeSeries Widget",
+		"chart": map[string]any{"type": "LineChart", "showLegend": true},
+		"dataSource": map[string]any{
+			"type": "timeSeries",
+			"properties": map[string]any{
+				"series": []any{map[string]any{
+					"type":                "metric",
+					"metric":              "synthetics.error_rate",
+					"aggregationFunction": "AVG",
+					"groupBy":             []any{"probe.region"},
+					"bucketGrouping":      []any{},
+				}},
+			},
+		},
+	}
+	widget := ReadDashboardWidget{Id: "123", Type: WidgetTypeTimeSeries, Properties: &properties}
+
+	got, err := widget.TypedProperties()
+	if err != nil {
+		t.Fatalf("ReadDashboardWidget.TypedProperties returned error: %v", err)
+	}
+
+	want := &TimeSeriesWidget{
+		Title:      "TimeSeries Widget",
+		Chart:      TimeSeriesChart{Type: "LineChart", ShowLegend: Ptr(true)},
+		DataSource: TimeSeriesDataSource(MetricSeries("synthetics.error_rate", "AVG", "probe.region")),
+	}
+	if !testObjects(t, got, want) {
+		t.Errorf("ReadDashboardWidget.TypedProperties returned %+v, want %+v", got, want)
+	}
+
+	widget.Type = "Unknown"
+	if _, err := widget.TypedProperties(); err == nil {
+		t.Error("ReadDashboardWidget.TypedProperties expected an error for an unknown widget type")
+	}
+	widget.Properties = nil
+	if _, err := widget.TypedProperties(); err == nil {
+		t.Error("ReadDashboardWidget.TypedProperties expected an error for missing properties")
+	}
+}
@@ -2,11 +2,15 @@ package client
 
 import (
 	"context"
-	"log"
+	"errors"
+	"fmt"
 )
 
 type DashboardsService service
 
+type DashboardsPaginator = Paginator[*OffsetPage[SearchDashboard], SearchDashboard]
+type DashboardWidgetsPaginator = Paginator[*OffsetPage[DashboardWidget], DashboardWidget]
+
 type CreateDashboardResult = createDashboardCreateDashboardCreateDashboardResponseDashboard
 type CreateDashboardLayout = createDashboardCreateDashboardCreateDashboardResponseDashboardLayout
 type CreateDashboardWidget = createDashboardCreateDashboardCreateDashboardResponseDashboardWidgetsWidget
@@ -19,11 +23,34 @@ type UpdateDashboardResult = updateDashboardUpdateDashboardUpdateDashboardRespon
 type UpdateDashboardLayout = updateDashboardUpdateDashboardUpdateDashboardResponseDashboardLayout
 type UpdateDashboardWidget = updateDashboardUpdateDashboardUpdateDashboardResponseDashboardWidgetsWidget
 
+// DashboardImportOptions changes how DashboardsService.Import imports a document.
+type DashboardImportOptions struct {
+	// Name replaces the name of the document, e.g. to import a copy of a dashboard.
+	Name *string
+	// CategoryId files the dashboard in the category with the given id instead of the category
+	// named by the document.
+	CategoryId *string
+}
+
+// ImportDashboardResult is the dashboard created or updated by DashboardsService.Import.
+type ImportDashboardResult struct {
+	Id string
+	// Created is true if a new dashboard was created, and false if an existing dashboard was
+	// updated.
+	Created bool
+}
+
 type DashboardsCommunicator interface {
 	Create(context.Context, CreateDashboardInput) (*CreateDashboardResult, error)
 	Read(context.Context, string) (*ReadDashboardResult, error)
 	Update(context.Context, UpdateDashboardInput) (*UpdateDashboardResult, error)
 	Delete(context.Context, string) error
+	Search(context.Context, SearchDashboardsInput) (*SearchDashboardsResult, error)
+	SearchAll(context.Context, SearchDashboardsInput, *ListOptions) *DashboardsPaginator
+	Widgets(context.Context, *ListOptions) ([]DashboardWidget, error)
+	WidgetsAll(context.Context, *ListOptions) *DashboardWidgetsPaginator
+	Export(context.Context, string) (*DashboardDocument, error)
+	Import(context.Context, DashboardDocument, *DashboardImportOptions) (*ImportDashboardResult, error)
 }
 
 func newDashboardsService(c *Client) *DashboardsService {
@@ -32,10 +59,10 @@ func newDashboardsService(c *Client) *DashboardsService {
 
 // Creates a new dashboard.
 func (service *DashboardsService) Create(ctx context.Context, input CreateDashboardInput) (*CreateDashboardResult, error) {
-	log.Printf("create dashboard request. name: %s", input.Name)
+	service.client.logger.DebugContext(ctx, "create dashboard request", "name", input.Name)
 
-	resp, err := doMutate(
-		func() (*createDashboardResponse, error) {
+	resp, err := doMutate(ctx,
+		func(ctx context.Context) (*createDashboardResponse, error) {
 			return createDashboard(ctx, service.client.gql, input)
 		},
 		func(resp *createDashboardResponse) error {
@@ -52,14 +79,14 @@ func (service *DashboardsService) Create(ctx context.Context, input CreateDashbo
 	}
 
 	dashboard := resp.CreateDashboard.Dashboard
-	log.Printf("create dashboard success. id: %s", dashboard.Id)
+	service.client.logger.DebugContext(ctx, "create dashboard success", "id", dashboard.Id)
 
 	return dashboard, nil
 }
 
 // Returns the dashboard identified by the given Id.
 func (service *DashboardsService) Read(ctx context.Context, id string) (*ReadDashboardResult, error) {
-	log.Printf("read dashboard request. id: %s", id)
+	service.client.logger.DebugContext(ctx, "read dashboard request", "id", id)
 
 	resp, err := getDashboardById(ctx, service.client.gql, id)
 
@@ -69,17 +96,17 @@ func (service *DashboardsService) Read(ctx context.Context, id string) (*ReadDas
 
 	dashboard := resp.Dashboards.ByIdOrSystemReference
 
-	log.Printf("read dashboard success. name: %s", dashboard.Id)
+	service.client.logger.DebugContext(ctx, "read dashboard success", "id", dashboard.Id)
 
 	return dashboard, nil
 }
 
 // Updates the dashboard.
 func (service *DashboardsService) Update(ctx context.Context, input UpdateDashboardInput) (*UpdateDashboardResult, error) {
-	log.Printf("update dashboard request. id: %s", input.Id)
+	service.client.logger.DebugContext(ctx, "update dashboard request", "id", input.Id)
 
-	resp, err := doMutate(
-		func() (*updateDashboardResponse, error) {
+	resp, err := doMutate(ctx,
+		func(ctx context.Context) (*updateDashboardResponse, error) {
 			return updateDashboard(ctx, service.client.gql, input)
 		},
 		func(resp *updateDashboardResponse) error {
@@ -95,17 +122,17 @@ func (service *DashboardsService) Update(ctx context.Context, input UpdateDashbo
 		return nil, err
 	}
 
-	log.Printf("update dashboard success. id: %s", input.Id)
+	service.client.logger.DebugContext(ctx, "update dashboard success", "id", input.Id)
 
 	return resp.UpdateDashboard.Dashboard, nil
 }
 
 // Deletes the dashboard with the given id.
 func (service *DashboardsService) Delete(ctx context.Context, id string) error {
-	log.Printf("delete dashboard request. id: %s", id)
+	service.client.logger.DebugContext(ctx, "delete dashboard request", "id", id)
 
-	_, err := doMutate(
-		func() (*deleteDashboardResponse, error) {
+	_, err := doMutate(ctx,
+		func(ctx context.Context) (*deleteDashboardResponse, error) {
 			return deleteDashboard(ctx, service.client.gql, DeleteDashboardInput{
 				Id: id,
 			})
@@ -123,7 +150,229 @@ func (service *DashboardsService) Delete(ctx context.Context, id string) error {
 		return err
 	}
 
-	log.Printf("delete dashboard success. id: %s", id)
+	service.client.logger.DebugContext(ctx, "delete dashboard success", "id", id)
 
 	return nil
 }
+
+// Returns one page of the dashboards matching the search input. Dashboards are matched by a
+// substring of their name, so callers that need an exact match must compare the names.
+func (service *DashboardsService) Search(ctx context.Context, input SearchDashboardsInput) (*SearchDashboardsResult, error) {
+	service.client.logger.DebugContext(ctx, "search dashboards request", "name", input.Name)
+
+	resp, err := searchDashboards(ctx, service.client.gql, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SearchDashboardsResult{}
+	if resp.Dashboards != nil {
+		result = &resp.Dashboards.Search
+	}
+
+	service.client.logger.DebugContext(ctx, "search dashboards success",
+		"count", len(result.Dashboards), "total", result.TotalDashboardsCount)
+
+	return result, nil
+}
+
+// Returns a paginator over every dashboard matching the search input. The pagination of the
+// input is replaced by the paging of the options.
+func (service *DashboardsService) SearchAll(ctx context.Context, input SearchDashboardsInput, opts *ListOptions) *DashboardsPaginator {
+	return NewPaginator(ctx,
+		func(ctx context.Context, paging *PagingInput) (*OffsetPage[SearchDashboard], error) {
+			pagination, err := offsetPagination(paging)
+			if err != nil {
+				return nil, err
+			}
+
+			pageInput := input
+			pageInput.Pagination = pagination
+			result, err := service.Search(ctx, pageInput)
+			if err != nil {
+				return nil, err
+			}
+
+			return &OffsetPage[SearchDashboard]{
+				Items:  result.Dashboards,
+				Offset: pagination.Offset,
+				Total:  result.TotalDashboardsCount,
+			}, nil
+		},
+		func(page *OffsetPage[SearchDashboard]) []SearchDashboard {
+			return page.Items
+		},
+		opts.paginatorOptions()...)
+}
+
+// Returns a single page of the widgets that can be added to a dashboard. The widget search pages
+// by offset: opts.Paging.First sets the page size, 100 by default, and opts.Paging.After the
+// offset of the page as a decimal string, like the cursors of WidgetsAll. The widget search is
+// not sorted, so opts.SortBy is ignored.
+func (service *DashboardsService) Widgets(ctx context.Context, opts *ListOptions) ([]DashboardWidget, error) {
+	service.client.logger.DebugContext(ctx, "search dashboard widgets request")
+
+	pagination, err := offsetPagination(opts.paging())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := searchDashboardWidgets(ctx, service.client.gql, SearchWidgetsInput{
+		Pagination: pagination,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var widgets []DashboardWidget
+	if resp.Dashboards != nil && resp.Dashboards.Widgets != nil {
+		widgets = resp.Dashboards.Widgets.Search
+	}
+
+	service.client.logger.DebugContext(ctx, "search dashboard widgets success", "count", len(widgets))
+
+	return widgets, nil
+}
+
+// Returns a paginator over every widget that can be added to a dashboard. The widget search does
+// not report a total, so paging stops at the first empty page.
+func (service *DashboardsService) WidgetsAll(ctx context.Context, opts *ListOptions) *DashboardWidgetsPaginator {
+	return NewPaginator(ctx,
+		func(ctx context.Context, paging *PagingInput) (*OffsetPage[DashboardWidget], error) {
+			pagination, err := offsetPagination(paging)
+			if err != nil {
+				return nil, err
+			}
+
+			widgets, err := service.Widgets(ctx, &ListOptions{Paging: paging})
+			if err != nil {
+				return nil, err
+			}
+
+			return &OffsetPage[DashboardWidget]{
+				Items:  widgets,
+				Offset: pagination.Offset,
+				Total:  -1,
+			}, nil
+		},
+		func(page *OffsetPage[DashboardWidget]) []DashboardWidget {
+			return page.Items
+		},
+		opts.paginatorOptions()...)
+}
+
+// Returns the portable document of the dashboard with the given id, which can be imported into
+// another organization or region with Import.
+func (service *DashboardsService) Export(ctx context.Context, id string) (*DashboardDocument, error) {
+	dashboard, err := service.Read(ctx, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return dashboard.Document(), nil
+}
+
+// Creates or updates the dashboard of the document. A dashboard with the same name is updated
+// and replaced by the document, otherwise a new dashboard is created. Every widget is given a new
+// id. The category named by the document is looked up by name and created if it does not exist.
+// The mode of the document is only set on new dashboards, as updates cannot change it. An error
+// is returned if more than one dashboard has the name.
+func (service *DashboardsService) Import(ctx context.Context, doc DashboardDocument, opts *DashboardImportOptions) (*ImportDashboardResult, error) {
+	if opts == nil {
+		opts = &DashboardImportOptions{}
+	}
+	if opts.Name != nil {
+		doc.Name = *opts.Name
+	}
+
+	service.client.logger.DebugContext(ctx, "import dashboard request", "name", doc.Name)
+
+	input, err := doc.CreateInput()
+	if err != nil {
+		return nil, err
+	}
+
+	// The dashboard is resolved first, so that a failed lookup does not leave a new category
+	// behind.
+	existing, err := service.readByName(ctx, doc.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	input.CategoryId = opts.CategoryId
+	if input.CategoryId == nil && doc.Category != nil {
+		category, err := service.importCategory(ctx, *doc.Category)
+		if err != nil {
+			return nil, err
+		}
+		input.CategoryId = &category.Id
+	}
+
+	var result *ImportDashboardResult
+	if existing == nil {
+		created, err := service.Create(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		result = &ImportDashboardResult{Id: created.Id, Created: true}
+	} else {
+		_, err = service.Update(ctx, UpdateDashboardInput{
+			Id:          existing.Id,
+			Name:        input.Name,
+			Description: input.Description,
+			CategoryId:  input.CategoryId,
+			Widgets:     input.Widgets,
+			Layout:      input.Layout,
+			Version:     input.Version,
+		})
+		if err != nil {
+			return nil, err
+		}
+		result = &ImportDashboardResult{Id: existing.Id}
+	}
+
+	service.client.logger.DebugContext(ctx, "import dashboard success", "id", result.Id, "created", result.Created)
+
+	return result, nil
+}
+
+// readByName returns the dashboard with the given name, or nil if no dashboard has the name.
+func (service *DashboardsService) readByName(ctx context.Context, name string) (*SearchDashboard, error) {
+	var matches []SearchDashboard
+	for dashboard, err := range service.SearchAll(ctx, SearchDashboardsInput{Name: &name}, nil).Items() {
+		if err != nil {
+			return nil, err
+		}
+		if dashboard.Name == name {
+			matches = append(matches, *dashboard)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &matches[0], nil
+	default:
+		ids := make([]string, len(matches))
+		for i, match := range matches {
+			ids[i] = match.Id
+		}
+		return nil, fmt.Errorf("dashboard %q is the name of %d dashboards: %v", name, len(matches), ids)
+	}
+}
+
+// importCategory returns the dashboard category with the given name, and creates it if it does
+// not exist.
+func (service *DashboardsService) importCategory(ctx context.Context, name string) (*DashboardCategory, error) {
+	categories := service.client.DashboardCategoriesService()
+
+	category, err := categories.ReadByName(ctx, name)
+	if errors.Is(err, ErrNotFound) {
+		return categories.Create(ctx, name)
+	}
+	return category, err
+}
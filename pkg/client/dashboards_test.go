@@ -235,6 +235,207 @@ func TestService_DeleteDashboard(t *testing.T) {
 	}
 }
 
+func TestService_ExportDashboard(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		sendGraphQLResponse(t, w, getDashboardByIdResponse{
+			Dashboards: &getDashboardByIdDashboardsDashboardQueries{
+				ByIdOrSystemReference: &getDashboardByIdDashboardsDashboardQueriesByIdOrSystemReferenceDashboard{
+					Id:        "123",
+					Version:   &dashboardsMockData.versionId,
+					Name:      dashboardsMockData.fieldName,
+					OwnerId:   &dashboardsMockData.fieldOwnerId,
+					CreatedAt: dashboardsMockData.fieldUpdatedAt,
+					UpdatedAt: dashboardsMockData.fieldUpdatedAt,
+					Layout: []getDashboardByIdDashboardsDashboardQueriesByIdOrSystemReferenceDashboardLayout{
+						{Id: "w-1", X: 0, Y: 0, Height: 2, Width: 2},
+					},
+					Widgets: []getDashboardByIdDashboardsDashboardQueriesByIdOrSystemReferenceDashboardWidgetsWidget{
+						{Id: "w-1", Type: "Proportional"},
+					},
+				},
+			},
+		})
+	})
+
+	got, err := client.DashboardsService().Export(ctx, "123")
+	if err != nil {
+		t.Fatalf("Swo.ExportDashboard returned error: %v", err)
+	}
+
+	want := &DashboardDocument{
+		Name:    dashboardsMockData.fieldName,
+		Version: &dashboardsMockData.versionId,
+		Widgets: []DashboardDocumentWidget{{Id: "w-1", Type: "Proportional"}},
+		Layout:  []LayoutInput{{Id: "w-1", X: 0, Y: 0, Height: 2, Width: 2}},
+	}
+	if !testObjects(t, got, want) {
+		t.Errorf("Swo.ExportDashboard returned %+v, wanted %+v", got, want)
+	}
+}
+
+func TestService_ImportDashboard(t *testing.T) {
+	doc := DashboardDocument{
+		Name:     dashboardsMockData.fieldName,
+		Version:  Ptr(2),
+		Mode:     Ptr(DashboardModeAnalysis),
+		Category: Ptr("ops"),
+		Widgets:  []DashboardDocumentWidget{{Id: "w-1", Type: WidgetTypeText}},
+		Layout:   []LayoutInput{{Id: "w-1", X: 0, Y: 0, Height: 1, Width: 12}},
+	}
+
+	tests := []struct {
+		name        string
+		opts        *DashboardImportOptions
+		existing    []SearchDashboard
+		categories  []DashboardCategoryCount
+		wantName    string
+		wantCreated bool
+	}{
+		{
+			name:        "create with new category",
+			wantName:    dashboardsMockData.fieldName,
+			wantCreated: true,
+		},
+		{
+			name:       "update by name",
+			existing:   []SearchDashboard{{Id: "other", Name: dashboardsMockData.fieldName + " (copy)"}, {Id: "123", Name: dashboardsMockData.fieldName}},
+			categories: mockDashboardCategories(),
+			wantName:   dashboardsMockData.fieldName,
+		},
+		{
+			name:        "renamed copy",
+			opts:        &DashboardImportOptions{Name: Ptr("copy"), CategoryId: Ptr("c-9")},
+			existing:    []SearchDashboard{{Id: "123", Name: dashboardsMockData.fieldName}},
+			wantName:    "copy",
+			wantCreated: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, client, server, _, teardown := setup()
+			defer teardown()
+
+			var operations []string
+			var widgets []WidgetInput
+			var layout []LayoutInput
+			var categoryId *string
+			var version *int
+
+			server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+				operation, err := getGraphQLOperation(r)
+				if err != nil {
+					t.Errorf("Swo.ImportDashboard error: %v", err)
+				}
+				operations = append(operations, operation)
+
+				switch operation {
+				case "searchDashboardCategories":
+					sendDashboardCategories(t, w, tt.categories)
+				case "createDashboardCategory":
+					sendGraphQLResponse(t, w, createDashboardCategoryResponse{
+						CreateCategory: createDashboardCategoryCreateCategoryCreateCategoryResponse{
+							Success:  true,
+							Category: &DashboardCategory{Id: "c-new", Name: "ops"},
+						},
+					})
+				case "searchDashboards":
+					gqlInput, _ := getGraphQLInput[__searchDashboardsInput](r)
+					if name := *gqlInput.Inputs.Name; name != tt.wantName {
+						t.Errorf("Swo.ImportDashboard searched for %q, want %q", name, tt.wantName)
+					}
+					sendGraphQLResponse(t, w, searchDashboardsResponse{
+						Dashboards: &searchDashboardsDashboardsDashboardQueries{Search: SearchDashboardsResult{
+							Dashboards:           tt.existing,
+							TotalDashboardsCount: len(tt.existing),
+						}},
+					})
+				case "createDashboard":
+					gqlInput, _ := getGraphQLInput[__createDashboardInput](r)
+					widgets, layout, categoryId = gqlInput.Input.Widgets, gqlInput.Input.Layout, gqlInput.Input.CategoryId
+					version = gqlInput.Input.Version
+					if mode := gqlInput.Input.Mode; mode == nil || *mode != DashboardModeAnalysis {
+						t.Errorf("Swo.ImportDashboard created mode %v, want %s", mode, DashboardModeAnalysis)
+					}
+					sendGraphQLResponse(t, w, createDashboardResponse{
+						CreateDashboard: createDashboardCreateDashboardCreateDashboardResponse{
+							Success:   true,
+							Dashboard: &createDashboardCreateDashboardCreateDashboardResponseDashboard{Id: "new"},
+						},
+					})
+				case "updateDashboard":
+					gqlInput, _ := getGraphQLInput[__updateDashboardInput](r)
+					if gqlInput.Input.Id != "123" {
+						t.Errorf("Swo.ImportDashboard updated %q, want 123", gqlInput.Input.Id)
+					}
+					widgets, layout, categoryId = gqlInput.Input.Widgets, gqlInput.Input.Layout, gqlInput.Input.CategoryId
+					version = gqlInput.Input.Version
+					sendGraphQLResponse(t, w, updateDashboardResponse{
+						UpdateDashboard: updateDashboardUpdateDashboardUpdateDashboardResponse{Success: true},
+					})
+				default:
+					t.Errorf("Swo.ImportDashboard sent unexpected operation %q", operation)
+				}
+			})
+
+			got, err := client.DashboardsService().Import(ctx, doc, tt.opts)
+			if err != nil {
+				t.Fatalf("Swo.ImportDashboard returned error: %v", err)
+			}
+
+			want := &ImportDashboardResult{Id: "123", Created: tt.wantCreated}
+			if tt.wantCreated {
+				want.Id = "new"
+			}
+			if !testObjects(t, got, want) {
+				t.Errorf("Swo.ImportDashboard returned %+v, wanted %+v (operations %v)", got, want, operations)
+			}
+
+			if len(widgets) != 1 || widgets[0].Id == "w-1" || layout[0].Id != widgets[0].Id {
+				t.Errorf("Swo.ImportDashboard sent widgets %+v and layout %+v, want new ids", widgets, layout)
+			}
+
+			wantCategoryId := "c-new"
+			if tt.opts != nil {
+				wantCategoryId = *tt.opts.CategoryId
+			} else if tt.categories != nil {
+				wantCategoryId = "c-1"
+			}
+			if categoryId == nil || *categoryId != wantCategoryId {
+				t.Errorf("Swo.ImportDashboard sent category %v, want %s", categoryId, wantCategoryId)
+			}
+			if version == nil || *version != 2 {
+				t.Errorf("Swo.ImportDashboard sent version %v, want 2", version)
+			}
+		})
+	}
+}
+
+func TestService_ImportDashboardAmbiguousName(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if operation, _ := getGraphQLOperation(r); operation != "searchDashboards" {
+			t.Errorf("Swo.ImportDashboard sent %q before resolving the dashboard", operation)
+		}
+		sendGraphQLResponse(t, w, searchDashboardsResponse{
+			Dashboards: &searchDashboardsDashboardsDashboardQueries{Search: SearchDashboardsResult{
+				Dashboards:           []SearchDashboard{{Id: "1", Name: "ops"}, {Id: "2", Name: "ops"}},
+				TotalDashboardsCount: 2,
+			}},
+		})
+	})
+
+	_, err := client.DashboardsService().Import(ctx, DashboardDocument{Name: "ops", Category: Ptr("new")}, nil)
+	if err == nil {
+		t.Error("Swo.ImportDashboard expected an error for an ambiguous name")
+	}
+}
+
 func TestService_DashboardsMutateError(t *testing.T) {
 	ctx, client, server, _, teardown := setup()
 	defer teardown()
@@ -277,6 +478,198 @@ func TestService_DashboardsServerErrors(t *testing.T) {
 	if err == nil {
 		t.Error("Swo.DashboardsServerErrors expected an error response")
 	}
+	_, err = client.DashboardsService().Search(ctx, SearchDashboardsInput{})
+	if err == nil {
+		t.Error("Swo.DashboardsServerErrors expected an error response")
+	}
+	_, err = client.DashboardsService().Widgets(ctx, nil)
+	if err == nil {
+		t.Error("Swo.DashboardsServerErrors expected an error response")
+	}
+	_, err = client.DashboardsService().Export(ctx, "123")
+	if err == nil {
+		t.Error("Swo.DashboardsServerErrors expected an error response")
+	}
+	_, err = client.DashboardsService().Import(ctx, DashboardDocument{Name: "ops"}, nil)
+	if err == nil {
+		t.Error("Swo.DashboardsServerErrors expected an error response")
+	}
+}
+
+func TestService_SearchDashboards(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	input := SearchDashboardsInput{
+		Name:       Ptr("swo"),
+		Pagination: &PaginationInput{Limit: 10},
+		Filter:     &DashboardFilter{CategoryId: &dashboardsMockData.fieldCategoryId},
+	}
+	want := &SearchDashboardsResult{
+		Dashboards: []SearchDashboard{
+			{
+				Id:        "123",
+				Name:      dashboardsMockData.fieldName,
+				Category:  &DashboardCategory{Id: dashboardsMockData.fieldCategoryId, Name: "ops"},
+				CreatedAt: dashboardsMockData.fieldUpdatedAt,
+				UpdatedAt: dashboardsMockData.fieldUpdatedAt,
+			},
+		},
+		TotalDashboardsCount: 1,
+	}
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__searchDashboardsInput](r)
+		if err != nil {
+			t.Errorf("Swo.SearchDashboards error: %v", err)
+		}
+		if !testObjects(t, gqlInput.Inputs, input) {
+			t.Errorf("Request got = %+v, want = %+v", gqlInput.Inputs, input)
+		}
+
+		sendGraphQLResponse(t, w, searchDashboardsResponse{
+			Dashboards: &searchDashboardsDashboardsDashboardQueries{Search: *want},
+		})
+	})
+
+	got, err := client.DashboardsService().Search(ctx, input)
+	if err != nil {
+		t.Fatalf("Swo.SearchDashboards returned error: %v", err)
+	}
+	if !testObjects(t, got, want) {
+		t.Errorf("Swo.SearchDashboards returned %+v, wanted %+v", got, want)
+	}
+}
+
+func TestService_SearchAllDashboards(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	var offsets []int
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__searchDashboardsInput](r)
+		if err != nil {
+			t.Errorf("Swo.SearchAllDashboards error: %v", err)
+		}
+
+		pagination := gqlInput.Inputs.Pagination
+		if pagination.Limit != 2 {
+			t.Errorf("Swo.SearchAllDashboards limit = %d, want 2", pagination.Limit)
+		}
+		offsets = append(offsets, pagination.Offset)
+
+		var dashboards []SearchDashboard
+		for i := pagination.Offset; i < min(pagination.Offset+pagination.Limit, 5); i++ {
+			dashboards = append(dashboards, SearchDashboard{Id: fmt.Sprint(i)})
+		}
+		sendGraphQLResponse(t, w, searchDashboardsResponse{
+			Dashboards: &searchDashboardsDashboardsDashboardQueries{Search: SearchDashboardsResult{
+				Dashboards:           dashboards,
+				TotalDashboardsCount: 5,
+			}},
+		})
+	})
+
+	got, err := client.DashboardsService().SearchAll(ctx, SearchDashboardsInput{}, &ListOptions{
+		Paging: &PagingInput{First: Ptr(2)},
+	}).Collect()
+	if err != nil {
+		t.Fatalf("Swo.SearchAllDashboards returned error: %v", err)
+	}
+
+	var ids []string
+	for _, dashboard := range got {
+		ids = append(ids, dashboard.Id)
+	}
+	if want := []string{"0", "1", "2", "3", "4"}; !testObjects(t, ids, want) {
+		t.Errorf("Swo.SearchAllDashboards returned %v, wanted %v", ids, want)
+	}
+	if want := []int{0, 2, 4}; !testObjects(t, offsets, want) {
+		t.Errorf("Swo.SearchAllDashboards requested offsets %v, wanted %v", offsets, want)
+	}
+}
+
+func TestService_DashboardWidgets(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	want := []DashboardWidget{
+		{Id: "kpi", Type: "Kpi", Title: Ptr("KPI")},
+		{Id: "table", Type: "Table"},
+	}
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__searchDashboardWidgetsInput](r)
+		if err != nil {
+			t.Errorf("Swo.DashboardWidgets error: %v", err)
+		}
+		if want := (SearchWidgetsInput{Pagination: &PaginationInput{Limit: 50, Offset: 100}}); !testObjects(t, gqlInput.Inputs, want) {
+			t.Errorf("Request got = %+v, want = %+v", gqlInput.Inputs, want)
+		}
+
+		sendGraphQLResponse(t, w, searchDashboardWidgetsResponse{
+			Dashboards: &searchDashboardWidgetsDashboardsDashboardQueries{
+				Widgets: &searchDashboardWidgetsDashboardsDashboardQueriesWidgetsWidgetQueries{Search: want},
+			},
+		})
+	})
+
+	got, err := client.DashboardsService().Widgets(ctx, &ListOptions{
+		Paging: &PagingInput{First: Ptr(50), After: Ptr("100")},
+	})
+	if err != nil {
+		t.Fatalf("Swo.DashboardWidgets returned error: %v", err)
+	}
+	if !testObjects(t, got, want) {
+		t.Errorf("Swo.DashboardWidgets returned %+v, wanted %+v", got, want)
+	}
+}
+
+func TestService_DashboardWidgetsAll(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	var offsets []int
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__searchDashboardWidgetsInput](r)
+		if err != nil {
+			t.Errorf("Swo.DashboardWidgetsAll error: %v", err)
+		}
+
+		pagination := gqlInput.Inputs.Pagination
+		if pagination.Limit != 2 {
+			t.Errorf("Swo.DashboardWidgetsAll limit = %d, want 2", pagination.Limit)
+		}
+		offsets = append(offsets, pagination.Offset)
+
+		var widgets []DashboardWidget
+		for i := pagination.Offset; i < min(pagination.Offset+pagination.Limit, 4); i++ {
+			widgets = append(widgets, DashboardWidget{Id: fmt.Sprint(i), Type: "Kpi"})
+		}
+		sendGraphQLResponse(t, w, searchDashboardWidgetsResponse{
+			Dashboards: &searchDashboardWidgetsDashboardsDashboardQueries{
+				Widgets: &searchDashboardWidgetsDashboardsDashboardQueriesWidgetsWidgetQueries{Search: widgets},
+			},
+		})
+	})
+
+	got, err := client.DashboardsService().WidgetsAll(ctx, &ListOptions{
+		Paging: &PagingInput{First: Ptr(2)},
+	}).Collect()
+	if err != nil {
+		t.Fatalf("Swo.DashboardWidgetsAll returned error: %v", err)
+	}
+
+	var ids []string
+	for _, widget := range got {
+		ids = append(ids, widget.Id)
+	}
+	if want := []string{"0", "1", "2", "3"}; !testObjects(t, ids, want) {
+		t.Errorf("Swo.DashboardWidgetsAll returned %v, wanted %v", ids, want)
+	}
+	if want := []int{0, 2, 4}; !testObjects(t, offsets, want) {
+		t.Errorf("Swo.DashboardWidgetsAll requested offsets %v, wanted %v", offsets, want)
+	}
 }
 
 func TestDashboard_Marshal(t *testing.T) {
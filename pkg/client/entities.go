@@ -0,0 +1,123 @@
+package client
+
+import (
+	"context"
+)
+
+type EntitiesService service
+
+// Exported Search types
+type SearchEntitiesResult = searchEntitiesEntitiesEntityQueriesSearchSearchEntitiesResult
+type SearchEntitiesGroup = searchEntitiesEntitiesEntityQueriesSearchSearchEntitiesResultGroupsEntitiesGroup
+type SearchEntitiesGrouping = searchEntitiesEntitiesEntityQueriesSearchSearchEntitiesResultGroupsEntitiesGroupGroupingEntityGrouping
+type SearchEntitiesGraphResult = searchEntitiesGraphEntitiesEntityQueriesSearchGraphSearchEntitiesGraphResult
+type SearchRelationshipsResult = searchRelationshipsEntitiesEntityQueriesSearchRelationshipsSearchRelationshipsResult
+type EntitiesPaginator = Paginator[*SearchEntitiesResult, Entity]
+type RelationshipsPaginator = Paginator[*SearchRelationshipsResult, Relationship]
+
+type EntitiesCommunicator interface {
+	Search(context.Context, EntityFilterInput, *EntityGroupByInput, *ListOptions) (*SearchEntitiesResult, error)
+	SearchAll(context.Context, EntityFilterInput, *ListOptions) *EntitiesPaginator
+	SearchGraph(context.Context, EntityFilterInput, EntityGraphTraversalInput, *ListOptions) (*SearchEntitiesGraphResult, error)
+	SearchRelationships(context.Context, RelationshipFilterInput, *ListOptions) (*SearchRelationshipsResult, error)
+	SearchRelationshipsAll(context.Context, RelationshipFilterInput, *ListOptions) *RelationshipsPaginator
+}
+
+func newEntitiesService(c *Client) *EntitiesService {
+	return &EntitiesService{c}
+}
+
+// Entities returns the entities of every group in the result.
+func (r *SearchEntitiesResult) Entities() []Entity {
+	var entities []Entity
+	for _, group := range r.Groups {
+		entities = append(entities, group.Entities...)
+	}
+	return entities
+}
+
+// Search returns a single page of entities matching the given filter, optionally grouped by
+// one or more properties. Sorting uses the property names and directions of opts.SortBy.
+func (s *EntitiesService) Search(ctx context.Context, filter EntityFilterInput, groupBy *EntityGroupByInput, opts *ListOptions) (*SearchEntitiesResult, error) {
+	s.client.logger.DebugContext(ctx, "search entities request", "types", filter.Types)
+
+	resp, err := searchEntities(ctx, s.client.gql, &filter, groupBy, entitySortBy(opts.sortBy()), opts.paging())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &resp.Entities.Search
+	s.client.logger.DebugContext(ctx, "search entities success", "count", len(result.Entities()))
+	return result, nil
+}
+
+// SearchAll returns a paginator over every entity matching the given filter.
+func (s *EntitiesService) SearchAll(ctx context.Context, filter EntityFilterInput, opts *ListOptions) *EntitiesPaginator {
+	return NewPaginator(ctx,
+		func(ctx context.Context, paging *PagingInput) (*SearchEntitiesResult, error) {
+			return s.Search(ctx, filter, nil, &ListOptions{Paging: paging, SortBy: opts.sortBy()})
+		},
+		func(page *SearchEntitiesResult) []Entity {
+			return page.Entities()
+		},
+		opts.paginatorOptions()...)
+}
+
+// SearchGraph finds the entities matching the given filter and then follows their
+// relationships according to the traversal. The result holds every entity and relationship
+// that was visited. Sorting is only supported for a traversal depth of at most 1.
+func (s *EntitiesService) SearchGraph(ctx context.Context, filter EntityFilterInput, traversal EntityGraphTraversalInput, opts *ListOptions) (*SearchEntitiesGraphResult, error) {
+	s.client.logger.DebugContext(ctx, "search entity graph request", "types", filter.Types)
+
+	resp, err := searchEntitiesGraph(ctx, s.client.gql, &filter, &traversal, entitySortBy(opts.sortBy()), opts.paging())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &resp.Entities.SearchGraph
+	s.client.logger.DebugContext(ctx, "search entity graph success",
+		"entities", len(result.Entities), "relationships", len(result.Relationships))
+	return result, nil
+}
+
+// SearchRelationships returns a single page of relationships matching the given filter.
+func (s *EntitiesService) SearchRelationships(ctx context.Context, filter RelationshipFilterInput, opts *ListOptions) (*SearchRelationshipsResult, error) {
+	s.client.logger.DebugContext(ctx, "search relationships request", "types", filter.Types)
+
+	resp, err := searchRelationships(ctx, s.client.gql, &filter, opts.paging())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &resp.Entities.SearchRelationships
+	s.client.logger.DebugContext(ctx, "search relationships success", "count", len(result.Relationships))
+	return result, nil
+}
+
+// SearchRelationshipsAll returns a paginator over every relationship matching the given filter.
+func (s *EntitiesService) SearchRelationshipsAll(ctx context.Context, filter RelationshipFilterInput, opts *ListOptions) *RelationshipsPaginator {
+	return NewPaginator(ctx,
+		func(ctx context.Context, paging *PagingInput) (*SearchRelationshipsResult, error) {
+			return s.SearchRelationships(ctx, filter, &ListOptions{Paging: paging})
+		},
+		func(page *SearchRelationshipsResult) []Relationship {
+			return page.Relationships
+		},
+		opts.paginatorOptions()...)
+}
+
+// entitySortBy converts a SortInput to the equivalent EntitySortInput.
+func entitySortBy(sortBy *SortInput) *EntitySortInput {
+	if sortBy == nil {
+		return nil
+	}
+
+	result := &EntitySortInput{}
+	for _, sort := range sortBy.Sorts {
+		result.Sorts = append(result.Sorts, EntitySortItemInput{
+			PropertyName: sort.PropertyName,
+			Direction:    sort.Direction,
+		})
+	}
+	return result
+}
@@ -0,0 +1,261 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+const mockEntityJSON = `{
+	"__typename": "Host",
+	"id": "e-1",
+	"type": "Host",
+	"name": "web-01",
+	"displayName": "Web 01",
+	"createdTime": "2024-01-01T00:00:00Z",
+	"isUnknown": false,
+	"maxUnknownPeriodMinutes": 30,
+	"extensions": ["hostAgent"],
+	"tags": [{"key": "env", "value": "prod"}],
+	"hostname": "web-01.example.com",
+	"cpuCount": 4
+}`
+
+func mockEntity(t *testing.T) Entity {
+	var entity Entity
+	if err := json.Unmarshal([]byte(mockEntityJSON), &entity); err != nil {
+		t.Fatal(err)
+	}
+	return entity
+}
+
+func TestEntity_UnmarshalJSON(t *testing.T) {
+	got := mockEntity(t)
+
+	if got.Id != "e-1" || got.Type != "Host" || *got.Name != "web-01" || *got.DisplayName != "Web 01" {
+		t.Errorf("Entity common fields = %+v", got)
+	}
+	if value, ok := got.Tag("env"); !ok || value != "prod" {
+		t.Errorf("Entity.Tag(env) = %s, %v, want prod, true", value, ok)
+	}
+	if _, ok := got.Tag("missing"); ok {
+		t.Error("Entity.Tag(missing) expected no value")
+	}
+	if *got.MaxUnknownPeriodMinutes != 30 || !testObjects(t, got.Extensions, []string{"hostAgent"}) {
+		t.Errorf("Entity interface fields = %+v", got)
+	}
+	if got.Attributes["__typename"] != "Host" || got.Attributes["isUnknown"] != false {
+		t.Errorf("Entity.Attributes = %+v", got.Attributes)
+	}
+	// Host fields are not typed on Entity and only reach the caller through Attributes.
+	if got.Attributes["hostname"] != "web-01.example.com" || got.Attributes["cpuCount"] != 4.0 {
+		t.Errorf("Entity.Attributes type-specific fields = %+v", got.Attributes)
+	}
+
+	// Marshalling keeps every attribute, so the entity survives a round trip.
+	data, err := json.Marshal(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var roundTrip Entity
+	if err := json.Unmarshal(data, &roundTrip); err != nil {
+		t.Fatal(err)
+	}
+	if !testObjects(t, roundTrip, got) {
+		t.Errorf("Entity round trip returned %+v, want %+v", roundTrip, got)
+	}
+}
+
+func TestEntities_Search(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	filter := EntityFilterInput{
+		Types:  []string{"Host"},
+		Filter: &FilterInput{PropertyName: Ptr("tags.env"), PropertyValue: Ptr("prod"), Operation: FilterOperationEq},
+	}
+	groupBy := &EntityGroupByInput{PropertyNames: []string{"type"}}
+	opts := &ListOptions{
+		Paging: &PagingInput{First: Ptr(10)},
+		SortBy: SortBy("name", SortDirectionAsc),
+	}
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__searchEntitiesInput](r)
+		if err != nil {
+			t.Errorf("Swo.SearchEntities returned error: %v", err)
+		}
+
+		want := __searchEntitiesInput{
+			Filter:  &filter,
+			GroupBy: groupBy,
+			SortBy: &EntitySortInput{Sorts: []EntitySortItemInput{
+				{PropertyName: "name", Direction: Ptr(SortDirectionAsc)},
+			}},
+			Paging: opts.Paging,
+		}
+		if !testObjects(t, *gqlInput, want) {
+			t.Errorf("Request input = %+v, want %+v", *gqlInput, want)
+		}
+
+		sendGraphQLResponse(t, w, []byte(`{"data": {"entities": {"search": {
+			"groups": [{
+				"id": "g-1",
+				"entities": [`+mockEntityJSON+`],
+				"grouping": [{"propertyName": "type", "propertyValue": "Host"}],
+				"totalEntitiesCount": 1
+			}],
+			"totalEntitiesCount": 1,
+			"pageInfo": {"hasNextPage": false, "hasPreviousPage": false}
+		}}}}`))
+	})
+
+	got, err := client.EntitiesService().Search(ctx, filter, groupBy, opts)
+	if err != nil {
+		t.Fatalf("Swo.SearchEntities error: %v", err)
+	}
+
+	want := &SearchEntitiesResult{
+		Groups: []SearchEntitiesGroup{{
+			Id:                 "g-1",
+			Entities:           []Entity{mockEntity(t)},
+			Grouping:           []SearchEntitiesGrouping{{PropertyName: "type", PropertyValue: Ptr("Host")}},
+			TotalEntitiesCount: Ptr(1),
+		}},
+		TotalEntitiesCount: Ptr(1),
+	}
+
+	if !testObjects(t, got, want) {
+		t.Errorf("Swo.SearchEntities returned %+v, want %+v", got, want)
+	}
+	if !testObjects(t, got.Entities(), []Entity{mockEntity(t)}) {
+		t.Errorf("Swo.SearchEntities Entities() returned %+v", got.Entities())
+	}
+}
+
+func TestEntities_SearchAll(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__searchEntitiesInput](r)
+		if err != nil {
+			t.Errorf("Swo.SearchAllEntities returned error: %v", err)
+		}
+
+		page := `{"hasNextPage": true, "endCursor": "1"}`
+		if gqlInput.Paging.After != nil {
+			page = `{"hasNextPage": false}`
+		}
+
+		sendGraphQLResponse(t, w, []byte(`{"data": {"entities": {"search": {
+			"groups": [{"id": "g-1", "entities": [`+mockEntityJSON+`]}],
+			"pageInfo": `+page+`
+		}}}}`))
+	})
+
+	got, err := client.EntitiesService().SearchAll(ctx, EntityFilterInput{Types: []string{"Host"}}, &ListOptions{
+		Paging: &PagingInput{First: Ptr(1)},
+	}).Collect()
+	if err != nil {
+		t.Fatalf("Swo.SearchAllEntities error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Errorf("Swo.SearchAllEntities returned %d entities, want 2", len(got))
+	}
+}
+
+func TestEntities_SearchGraph(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	filter := EntityFilterInput{Types: []string{"KubernetesCluster"}}
+	traversal := EntityGraphTraversalInput{OutRelationshipTypes: []string{"Contains"}, Depth: Ptr(2)}
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__searchEntitiesGraphInput](r)
+		if err != nil {
+			t.Errorf("Swo.SearchEntitiesGraph returned error: %v", err)
+		}
+
+		want := __searchEntitiesGraphInput{Filter: &filter, Traversal: &traversal}
+		if !testObjects(t, *gqlInput, want) {
+			t.Errorf("Request input = %+v, want %+v", *gqlInput, want)
+		}
+
+		sendGraphQLResponse(t, w, []byte(`{"data": {"entities": {"searchGraph": {
+			"entities": [`+mockEntityJSON+`],
+			"relationships": [{"type": "Contains", "from": `+mockEntityJSON+`, "to": `+mockEntityJSON+`}],
+			"totalEntitiesCount": 1,
+			"pageInfo": {"hasNextPage": false, "hasPreviousPage": false}
+		}}}}`))
+	})
+
+	got, err := client.EntitiesService().SearchGraph(ctx, filter, traversal, nil)
+	if err != nil {
+		t.Fatalf("Swo.SearchEntitiesGraph error: %v", err)
+	}
+
+	want := &SearchEntitiesGraphResult{
+		Entities:           []Entity{mockEntity(t)},
+		Relationships:      []Relationship{{Type: "Contains", From: mockEntity(t), To: mockEntity(t)}},
+		TotalEntitiesCount: Ptr(1),
+	}
+
+	if !testObjects(t, got, want) {
+		t.Errorf("Swo.SearchEntitiesGraph returned %+v, want %+v", got, want)
+	}
+}
+
+func TestEntities_SearchRelationships(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	filter := RelationshipFilterInput{Types: []string{"Calls"}}
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__searchRelationshipsInput](r)
+		if err != nil {
+			t.Errorf("Swo.SearchRelationships returned error: %v", err)
+		}
+
+		want := __searchRelationshipsInput{Filter: &filter, Paging: &PagingInput{}}
+		if !testObjects(t, *gqlInput, want) {
+			t.Errorf("Request input = %+v, want %+v", *gqlInput, want)
+		}
+
+		sendGraphQLResponse(t, w, []byte(`{"data": {"entities": {"searchRelationships": {
+			"relationships": [{"type": "Calls", "from": `+mockEntityJSON+`, "to": `+mockEntityJSON+`, "contexts": ["c-1"]}],
+			"totalRelationshipsCount": 1,
+			"pageInfo": {"hasNextPage": false, "hasPreviousPage": false}
+		}}}}`))
+	})
+
+	got, err := client.EntitiesService().SearchRelationshipsAll(ctx, filter, nil).Collect()
+	if err != nil {
+		t.Fatalf("Swo.SearchRelationships error: %v", err)
+	}
+
+	want := []Relationship{{Type: "Calls", From: mockEntity(t), To: mockEntity(t), Contexts: []string{"c-1"}}}
+
+	if !testObjects(t, got, want) {
+		t.Errorf("Swo.SearchRelationships returned %+v, want %+v", got, want)
+	}
+}
+
+func TestEntities_ServerErrors(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", httpErrorResponse)
+
+	if _, err := client.EntitiesService().Search(ctx, EntityFilterInput{}, nil, nil); err == nil {
+		t.Error("Swo.EntitiesServerErrors expected an error response")
+	}
+	if _, err := client.EntitiesService().SearchGraph(ctx, EntityFilterInput{}, EntityGraphTraversalInput{}, nil); err == nil {
+		t.Error("Swo.EntitiesServerErrors expected an error response")
+	}
+	if _, err := client.EntitiesService().SearchRelationships(ctx, RelationshipFilterInput{}, nil); err == nil {
+		t.Error("Swo.EntitiesServerErrors expected an error response")
+	}
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"encoding/json"
+	"maps"
+)
+
+// EntityTag is a key-value tag set on an entity.
+type EntityTag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// Entity is an entity of any type returned by an entity search, e.g. a host, service or
+// Kubernetes object. The fields common to every entity type are typed. Attributes holds the
+// response fields keyed by their GraphQL name, which includes the type-specific fields the
+// searches select for hosts, services and Kubernetes clusters, nodes, namespaces, deployments
+// and pods. Other entity types only have the common fields.
+type Entity struct {
+	Id                      string         `json:"id"`
+	Type                    string         `json:"type"`
+	Name                    *string        `json:"name,omitempty"`
+	DisplayName             *string        `json:"displayName,omitempty"`
+	CreatedTime             *string        `json:"createdTime,omitempty"`
+	UpdatedTime             *string        `json:"updatedTime,omitempty"`
+	LastSeenTime            *string        `json:"lastSeenTime,omitempty"`
+	IsUnknown               *bool          `json:"isUnknown,omitempty"`
+	MaxUnknownPeriodMinutes *int           `json:"maxUnknownPeriodMinutes,omitempty"`
+	Extensions              []string       `json:"extensions,omitempty"`
+	Tags                    []EntityTag    `json:"tags,omitempty"`
+	Attributes              map[string]any `json:"-"`
+}
+
+// entityFields has the same fields as Entity without its JSON methods.
+type entityFields Entity
+
+// Tag returns the value of the tag with the given key.
+func (e *Entity) Tag(key string) (string, bool) {
+	for _, tag := range e.Tags {
+		if tag.Key == key {
+			return tag.Value, true
+		}
+	}
+	return "", false
+}
+
+// UnmarshalJSON decodes the common fields and keeps every response field in Attributes.
+func (e *Entity) UnmarshalJSON(data []byte) error {
+	var fields entityFields
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	var attributes map[string]any
+	if err := json.Unmarshal(data, &attributes); err != nil {
+		return err
+	}
+
+	*e = Entity(fields)
+	e.Attributes = attributes
+	return nil
+}
+
+// MarshalJSON encodes Attributes with the common fields set over them.
+func (e Entity) MarshalJSON() ([]byte, error) {
+	common, err := json.Marshal(entityFields(e))
+	if err != nil {
+		return nil, err
+	}
+
+	var result map[string]any
+	if err := json.Unmarshal(common, &result); err != nil {
+		return nil, err
+	}
+
+	attributes := maps.Clone(e.Attributes)
+	if attributes == nil {
+		attributes = map[string]any{}
+	}
+	maps.Copy(attributes, result)
+
+	return json.Marshal(attributes)
+}
+
+// Relationship is a directional relationship between two entities.
+type Relationship struct {
+	Type        string   `json:"type"`
+	From        Entity   `json:"from"`
+	To          Entity   `json:"to"`
+	CreatedTime *string  `json:"createdTime"`
+	UpdatedTime *string  `json:"updatedTime"`
+	Contexts    []string `json:"contexts"`
+}
@@ -0,0 +1,223 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// APIError is returned by every service when the server rejects a request, either with a
+// GraphQL error, a non-200 HTTP status, or an unsuccessful mutation response. Use errors.As
+// to get at the details and errors.Is to test for ErrNotFound, ErrUnauthorized, ErrForbidden,
+// ErrValidation and ErrRateLimited.
+type APIError struct {
+	// Operation is the name of the GraphQL operation, e.g. createAlertDefinitionMutation.
+	Operation string
+	// StatusCode is the HTTP status of the response. It is 200 for GraphQL errors and failed
+	// mutations that were delivered in a successful response.
+	StatusCode int
+	// Code is the error code reported by the server, if any.
+	Code string
+	// Message is the error message reported by the server.
+	Message string
+	// Path is the GraphQL path of the field that failed, e.g. alertMutations.createAlertDefinition.
+	Path string
+	// Extensions holds any additional error information reported by the server.
+	Extensions map[string]any
+	// RequestID is the X-Request-Id of the failed request, useful when reporting problems.
+	RequestID string
+	// Errors holds every GraphQL error in the response. The fields above describe the first.
+	Errors gqlerror.List
+	// PartialData is true when the server returned data alongside the errors.
+	PartialData bool
+
+	// summary overrides the default error text for failed mutations.
+	summary string
+	err     error
+}
+
+func (e *APIError) Error() string {
+	summary := e.summary
+	if summary == "" {
+		summary = fmt.Sprintf("%s failed", e.Operation)
+	}
+
+	code := e.Code
+	if code == "" && e.StatusCode != 0 && e.StatusCode != http.StatusOK {
+		code = strconv.Itoa(e.StatusCode)
+	}
+
+	// Empty fields are left out, e.g. for failures the server reports without a code.
+	msg := summary + "."
+	if code != "" {
+		msg += " code=" + code
+	}
+	if e.Message != "" {
+		msg += " message=" + e.Message
+	}
+	if e.Path != "" {
+		msg += " path=" + e.Path
+	}
+
+	return msg
+}
+
+// Unwrap returns the underlying genqlient error, if any.
+func (e *APIError) Unwrap() error {
+	return e.err
+}
+
+// Is reports whether the error matches one of the package's sentinel errors.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound || e.hasCode("NOT_FOUND", "404")
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.hasCode("UNAUTHORIZED", "UNAUTHENTICATED", "401")
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden || e.hasCode("FORBIDDEN", "403")
+	case ErrValidation:
+		return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity ||
+			e.hasCode("BAD_REQUEST", "BAD_USER_INPUT", "VALIDATION_ERROR", "GRAPHQL_VALIDATION_FAILED",
+				"GRAPHQL_PARSE_FAILED", "INVALID_INPUT", "INVALID_REGEX", "400", "422")
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests || e.hasCode("RATE_LIMITED", "TOO_MANY_REQUESTS", "429")
+	}
+
+	return false
+}
+
+func (e *APIError) hasCode(codes ...string) bool {
+	for _, code := range codes {
+		if strings.EqualFold(e.Code, code) {
+			return true
+		}
+	}
+	return false
+}
+
+// requestInfo carries details of the request being made through the context so they are
+// available when an error is built.
+type requestInfo struct {
+	operation  string
+	requestID  string
+	mutation   bool
+	statusCode int
+	retries    int
+}
+
+type requestInfoKey struct{}
+
+// withRequestInfo returns a context carrying a requestInfo, reusing one that is already present.
+func withRequestInfo(ctx context.Context) (context.Context, *requestInfo) {
+	if info := requestInfoFrom(ctx); info != nil {
+		return ctx, info
+	}
+
+	info := &requestInfo{}
+	return context.WithValue(ctx, requestInfoKey{}, info), info
+}
+
+func requestInfoFrom(ctx context.Context) *requestInfo {
+	if ctx == nil {
+		return nil
+	}
+
+	info, _ := ctx.Value(requestInfoKey{}).(*requestInfo)
+	return info
+}
+
+// apiErrorClient wraps a graphql.Client and converts server errors into *APIError.
+type apiErrorClient struct {
+	client graphql.Client
+}
+
+func (c *apiErrorClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	ctx, info := withRequestInfo(ctx)
+	info.operation = req.OpName
+	info.requestID = uuid.NewString()
+	info.mutation = operationType(req.Query) == "mutation"
+
+	err := c.client.MakeRequest(ctx, req, resp)
+	if err == nil {
+		return nil
+	}
+
+	apiErr := &APIError{
+		Operation: info.operation,
+		RequestID: info.requestID,
+		err:       err,
+	}
+
+	var httpErr *graphql.HTTPError
+	var gqlErrs gqlerror.List
+
+	switch {
+	case errors.As(err, &httpErr):
+		apiErr.StatusCode = httpErr.StatusCode
+		apiErr.Errors = httpErr.Response.Errors
+	case errors.As(err, &gqlErrs):
+		apiErr.StatusCode = http.StatusOK
+		apiErr.Errors = gqlErrs
+		apiErr.PartialData = resp.Data != nil && !isZeroData(resp.Data)
+	default:
+		// Transport errors such as timeouts and cancellation are returned unchanged.
+		return err
+	}
+
+	if len(apiErr.Errors) > 0 {
+		first := apiErr.Errors[0]
+		apiErr.Message = first.Message
+		apiErr.Extensions = first.Extensions
+		if len(first.Path) > 0 {
+			apiErr.Path = first.Path.String()
+		}
+		if code, ok := first.Extensions["code"].(string); ok {
+			apiErr.Code = code
+		}
+	}
+
+	return apiErr
+}
+
+// isZeroData reports whether no data was decoded into the given response data pointer.
+func isZeroData(data any) bool {
+	v := reflect.ValueOf(data)
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return true
+		}
+		v = v.Elem()
+	}
+	return v.IsZero()
+}
+
+// mutateError returns the error for a mutation which the server reported as unsuccessful.
+// The operation and request id are filled in by doMutate.
+func mutateError(localMessage string, serverCode string, serverMessage string) error {
+	return &APIError{
+		StatusCode: http.StatusOK,
+		Code:       serverCode,
+		Message:    serverMessage,
+		summary:    localMessage,
+	}
+}
+
+// mutateUnknownError returns the error for a mutation whose response only reports failure,
+// without a code or message from the server. The error wraps ErrUnknown.
+func mutateUnknownError(localMessage string) error {
+	return &APIError{
+		StatusCode: http.StatusOK,
+		Message:    ErrUnknown.Error(),
+		summary:    localMessage,
+		err:        ErrUnknown,
+	}
+}
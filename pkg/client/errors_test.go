@@ -0,0 +1,202 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestAPIError_GraphQLError(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	var sentRequestId string
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		sentRequestId = r.Header.Get(requestIdentifier)
+
+		sendGraphQLResponse(t, w, []byte(`{
+			"data": {"alertMutations": {"createAlertDefinition": null}},
+			"errors": [{
+				"message": "alert definition name is required",
+				"path": ["alertMutations", "createAlertDefinition"],
+				"extensions": {"code": "BAD_USER_INPUT", "field": "name"}
+			}]
+		}`))
+	})
+
+	_, err := client.AlertsService().Create(ctx, AlertDefinitionInput{})
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Swo.APIError expected *APIError, got %T: %v", err, err)
+	}
+
+	// APIError has unexported fields, so compare the exported ones through a plain struct.
+	type apiErrorFields struct {
+		Operation   string
+		StatusCode  int
+		Code        string
+		Message     string
+		Path        string
+		Extensions  map[string]any
+		RequestID   string
+		PartialData bool
+	}
+
+	want := apiErrorFields{
+		Operation:  "createAlertDefinitionMutation",
+		StatusCode: http.StatusOK,
+		Code:       "BAD_USER_INPUT",
+		Message:    "alert definition name is required",
+		Path:       "alertMutations.createAlertDefinition",
+		Extensions: map[string]any{"code": "BAD_USER_INPUT", "field": "name"},
+		RequestID:  sentRequestId,
+		// The only field in the response is the one that failed, so there is no partial data.
+		PartialData: false,
+	}
+
+	got := apiErrorFields{
+		Operation:   apiErr.Operation,
+		StatusCode:  apiErr.StatusCode,
+		Code:        apiErr.Code,
+		Message:     apiErr.Message,
+		Path:        apiErr.Path,
+		Extensions:  apiErr.Extensions,
+		RequestID:   apiErr.RequestID,
+		PartialData: apiErr.PartialData,
+	}
+
+	if !testObjects(t, got, want) {
+		t.Errorf("Swo.APIError returned %+v, want %+v", got, want)
+	}
+	if sentRequestId == "" {
+		t.Error("Swo.APIError expected a request id to be sent")
+	}
+	if !errors.Is(err, ErrValidation) || errors.Is(err, ErrNotFound) {
+		t.Errorf("Swo.APIError errors.Is mismatch for %v", err)
+	}
+}
+
+func TestAPIError_ServerRequestId(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(requestIdentifier, "server-request-id")
+		sendGraphQLResponse(t, w, []byte(`{"errors": [{"message": "not found", "extensions": {"code": "NOT_FOUND"}}]}`))
+	})
+
+	_, err := client.DashboardsService().Read(ctx, "123")
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Swo.APIError expected *APIError, got %T: %v", err, err)
+	}
+	if apiErr.RequestID != "server-request-id" {
+		t.Errorf("Swo.APIError RequestID = %s, want server-request-id", apiErr.RequestID)
+	}
+	if apiErr.PartialData {
+		t.Error("Swo.APIError expected no partial data")
+	}
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Swo.APIError expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestAPIError_HTTPStatus(t *testing.T) {
+	tests := map[int]error{
+		http.StatusUnauthorized:    ErrUnauthorized,
+		http.StatusForbidden:       ErrForbidden,
+		http.StatusNotFound:        ErrNotFound,
+		http.StatusTooManyRequests: ErrRateLimited,
+		http.StatusBadRequest:      ErrValidation,
+	}
+
+	for status, sentinel := range tests {
+		// Retries are disabled so the rate limited response is returned straight away.
+		client := setupRetry(t, RetryPolicy{MaxAttempts: 1}, func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, http.StatusText(status), status)
+		})
+
+		_, err := client.ApiTokenService().Read(context.Background(), "123")
+		if !errors.Is(err, sentinel) {
+			t.Errorf("Swo.APIError status %d: got %v, want %v", status, err, sentinel)
+		}
+
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode != status {
+			t.Errorf("Swo.APIError StatusCode = %d, want %d", apiErr.StatusCode, status)
+		}
+	}
+}
+
+func TestAPIError_MutationFailure(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		sendGraphQLResponse(t, w, deleteDashboardResponse{
+			DeleteDashboard: deleteDashboardDeleteDashboardDeleteDashboardResponse{
+				Code:    "404",
+				Success: false,
+				Message: "dashboard does not exist",
+			},
+		})
+	})
+
+	err := client.DashboardsService().Delete(ctx, "123")
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Swo.APIError expected *APIError, got %T: %v", err, err)
+	}
+	if apiErr.Operation != "deleteDashboard" || apiErr.RequestID == "" {
+		t.Errorf("Swo.APIError expected operation and request id, got %+v", apiErr)
+	}
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Swo.APIError expected ErrNotFound, got %v", err)
+	}
+
+	want := "delete dashboard failed. code=404 message=dashboard does not exist"
+	if err.Error() != want {
+		t.Errorf("Swo.APIError Error() = %s, want %s", err.Error(), want)
+	}
+}
+
+func TestAPIError_ErrorEmptyFields(t *testing.T) {
+	tests := []struct {
+		err  *APIError
+		want string
+	}{
+		{&APIError{summary: "update failed", StatusCode: http.StatusOK}, "update failed."},
+		{&APIError{summary: "update failed", Message: "unknown error"}, "update failed. message=unknown error"},
+		{&APIError{Operation: "getAlert", StatusCode: http.StatusBadGateway}, "getAlert failed. code=502"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.err.Error(); got != tt.want {
+			t.Errorf("APIError.Error() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestAPIError_NotFoundReads(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		sendGraphQLResponse(t, w, []byte(`{"data": {
+			"vcs": {"getCircleCIConnection": null},
+			"user": {"currentOrganization": {"tokens": []}}
+		}}`))
+	})
+
+	if _, err := client.CircleCIIntegrationService().Read(ctx, "123"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Swo.CircleCIIntegrationService.Read returned %v, want %v", err, ErrNotFound)
+	}
+	if _, err := client.ApiTokenService().Read(ctx, "123"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Swo.ApiTokenService.Read returned %v, want %v", err, ErrNotFound)
+	}
+}
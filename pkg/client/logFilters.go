@@ -2,9 +2,6 @@ package client
 
 import (
 	"context"
-	"errors"
-	"fmt"
-	"log"
 )
 
 type LogFilterService service
@@ -25,28 +22,34 @@ func newLogFilterService(c *Client) *LogFilterService {
 
 // Creates a new LogFilter entity with the given input.
 func (as *LogFilterService) Create(ctx context.Context, input CreateExclusionFilterInput) (*CreateLogFilterResult, error) {
-	log.Printf("create LogFilter request. name=%s", input.Name)
+	as.client.logger.DebugContext(ctx, "create LogFilter request", "name", input.Name)
+
+	resp, err := doMutate(ctx,
+		func(ctx context.Context) (*createLogFilterResponse, error) {
+			return createLogFilter(ctx, as.client.gql, input)
+		},
+		func(resp *createLogFilterResponse) error {
+			if !resp.CreateExclusionFilter.Success {
+				return mutateError("create LogFilter failed",
+					string(resp.CreateExclusionFilter.Code),
+					resp.CreateExclusionFilter.Message)
+			}
+			return nil
+		})
 
-	resp, err := createLogFilter(ctx, as.client.gql, input)
 	if err != nil {
+		as.client.logger.WarnContext(ctx, "create LogFilter failed", "error", err)
 		return nil, err
 	}
 
-	createFilter := resp.CreateExclusionFilter
-	if createFilter.Success == false {
-		err := fmt.Sprintf("create LogFilter failed. code=%s message=%s", createFilter.Code, createFilter.Message)
-		log.Print(err)
-		return nil, errors.New(err)
-	}
-
-	filter := createFilter.ExclusionFilter
-	log.Printf("create LogFilter success. id=%s", filter.Id)
+	filter := resp.CreateExclusionFilter.ExclusionFilter
+	as.client.logger.DebugContext(ctx, "create LogFilter success", "id", filter.Id)
 	return filter, nil
 }
 
 // Returns the LogFilter entity with the given Id.
 func (as *LogFilterService) Read(ctx context.Context, id string) (*ReadLogFilterResult, error) {
-	log.Printf("read logFilter request. id=%s", id)
+	as.client.logger.DebugContext(ctx, "read logFilter request", "id", id)
 
 	resp, err := getLogFilterById(ctx, as.client.gql, GetExclusionFilterInput{Id: id})
 	if err != nil {
@@ -58,24 +61,24 @@ func (as *LogFilterService) Read(ctx context.Context, id string) (*ReadLogFilter
 
 // Updates the LogFilter with input for the given id.
 func (as *LogFilterService) Update(ctx context.Context, input UpdateExclusionFilterInput) error {
-	log.Printf("update logFilter request. id=%s", input.Id)
+	as.client.logger.DebugContext(ctx, "update logFilter request", "id", input.Id)
 
 	if _, err := updateLogFilter(ctx, as.client.gql, input); err != nil {
 		return err
 	}
 
-	log.Printf("update logFilter success. id=%s", input.Id)
+	as.client.logger.DebugContext(ctx, "update logFilter success", "id", input.Id)
 	return nil
 }
 
 // Deletes the LogFilter with the given id.
 func (as *LogFilterService) Delete(ctx context.Context, id string) error {
-	log.Printf("delete logFilter request. id=%s", id)
+	as.client.logger.DebugContext(ctx, "delete logFilter request", "id", id)
 
 	if _, err := deleteLogFilter(ctx, as.client.gql, DeleteExclusionFilterInput{id}); err != nil {
 		return err
 	}
 
-	log.Printf("delete logFilter success. id=%s", id)
+	as.client.logger.DebugContext(ctx, "delete logFilter success", "id", id)
 	return nil
 }
@@ -0,0 +1,156 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"strings"
+)
+
+const redacted = "[REDACTED]"
+
+// Headers whose values are never written to the log.
+var redactedHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+// JSON keys, compared without case, whose values are never written to the log. Keys
+// containing "password" or "secret" are redacted as well.
+var redactedKeys = map[string]bool{
+	"token":          true,
+	"apitoken":       true,
+	"authtoken":      true,
+	"accesstoken":    true,
+	"refreshtoken":   true,
+	"apikey":         true,
+	"accesskey":      true,
+	"privatekey":     true,
+	"routingkey":     true,
+	"integrationkey": true,
+	"authorization":  true,
+}
+
+// discardHandler is a slog.Handler which drops every record. It is used when no logger is given.
+type discardHandler struct{}
+
+func (discardHandler) Enabled(context.Context, slog.Level) bool  { return false }
+func (discardHandler) Handle(context.Context, slog.Record) error { return nil }
+func (h discardHandler) WithAttrs([]slog.Attr) slog.Handler      { return h }
+func (h discardHandler) WithGroup(string) slog.Handler           { return h }
+
+func newDiscardLogger() *slog.Logger {
+	return slog.New(discardHandler{})
+}
+
+// newDebugLogger returns the logger used by DebugOption when no logger is given.
+func newDebugLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+}
+
+func isRedactedKey(key string) bool {
+	key = strings.ToLower(key)
+	return redactedKeys[key] || strings.Contains(key, "password") || strings.Contains(key, "secret")
+}
+
+// redactJSON returns the JSON document with the values of secret fields replaced. Anything
+// which isn't valid JSON is returned unchanged.
+func redactJSON(data []byte) []byte {
+	var doc any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return data
+	}
+
+	result, err := json.Marshal(redactValue(doc))
+	if err != nil {
+		return data
+	}
+
+	return result
+}
+
+func redactValue(v any) any {
+	switch typed := v.(type) {
+	case map[string]any:
+		for key, value := range typed {
+			if isRedactedKey(key) {
+				typed[key] = redacted
+			} else {
+				typed[key] = redactValue(value)
+			}
+		}
+	case []any:
+		for i, value := range typed {
+			typed[i] = redactValue(value)
+		}
+	}
+	return v
+}
+
+func redactHeader(header http.Header) http.Header {
+	clone := header.Clone()
+	for _, name := range redactedHeaders {
+		if clone.Get(name) != "" {
+			clone.Set(name, redacted)
+		}
+	}
+	return clone
+}
+
+// dumpRequest returns the request as it is sent on the wire with secrets redacted. The
+// request body is left unread.
+func dumpRequest(req *http.Request) (string, error) {
+	var data []byte
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return "", err
+		}
+		defer body.Close()
+
+		if data, err = io.ReadAll(body); err != nil {
+			return "", err
+		}
+		data = redactJSON(data)
+	}
+
+	clone := req.Clone(req.Context())
+	clone.Header = redactHeader(req.Header)
+	clone.Body = io.NopCloser(bytes.NewReader(data))
+	clone.ContentLength = int64(len(data))
+
+	dump, err := httputil.DumpRequestOut(clone, true)
+	if err != nil {
+		return "", err
+	}
+
+	return string(dump), nil
+}
+
+// dumpResponse returns the response with secrets redacted. The response body is replaced
+// so it can still be read by the caller.
+func dumpResponse(resp *http.Response) (string, error) {
+	var data []byte
+	if resp.Body != nil {
+		var err error
+		data, err = io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(data))
+		if err != nil {
+			return "", err
+		}
+	}
+
+	clone := *resp
+	clone.Header = redactHeader(resp.Header)
+	clone.Body = nil
+
+	dump, err := httputil.DumpResponse(&clone, false)
+	if err != nil {
+		return "", err
+	}
+
+	return string(append(dump, redactJSON(data)...)), nil
+}
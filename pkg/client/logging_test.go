@@ -0,0 +1,154 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// logRecorder collects the records written by a JSON slog handler.
+type logRecorder struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (r *logRecorder) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.buf.Write(p)
+}
+
+func (r *logRecorder) records(t *testing.T) []map[string]any {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(r.buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		record := map[string]any{}
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("invalid log record %s: %v", line, err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func (r *logRecorder) find(msg string, records []map[string]any) map[string]any {
+	for _, record := range records {
+		if record["msg"] == msg {
+			return record
+		}
+	}
+	return nil
+}
+
+func setupLogging(t *testing.T, opts ...ClientOption) (*Client, *http.ServeMux, *logRecorder) {
+	_, _, server, serverURL, teardown := setup()
+	t.Cleanup(teardown)
+
+	recorder := &logRecorder{}
+	logger := slog.New(slog.NewJSONHandler(recorder, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	opts = append([]ClientOption{BaseUrlOption(serverURL + baseURLPath + "/"), LoggerOption(logger)}, opts...)
+	client, err := New("secret-api-token", opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client, server, recorder
+}
+
+func TestLoggerOption_StructuredAttributes(t *testing.T) {
+	client, server, recorder := setupLogging(t)
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(requestIdentifier, "server-request-id")
+		sendGraphQLResponse(t, w, deleteDashboardResponse{
+			DeleteDashboard: deleteDashboardDeleteDashboardDeleteDashboardResponse{Success: true},
+		})
+	})
+
+	if err := client.DashboardsService().Delete(context.Background(), "123"); err != nil {
+		t.Fatalf("Swo.DashboardsService.Delete returned error: %v", err)
+	}
+
+	records := recorder.records(t)
+
+	request := recorder.find("delete dashboard request", records)
+	if request == nil || request["id"] != "123" || request["level"] != "DEBUG" {
+		t.Errorf("Swo.Logger expected a delete dashboard request record with the id, got %v", request)
+	}
+
+	graphql := recorder.find("graphql request", records)
+	if graphql == nil {
+		t.Fatalf("Swo.Logger expected a graphql request record, got %v", records)
+	}
+	if graphql["operation"] != "deleteDashboard" {
+		t.Errorf("Swo.Logger operation = %v, want deleteDashboard", graphql["operation"])
+	}
+	if graphql["request_id"] != "server-request-id" {
+		t.Errorf("Swo.Logger request_id = %v, want server-request-id", graphql["request_id"])
+	}
+	if _, ok := graphql["duration"]; !ok {
+		t.Error("Swo.Logger expected a duration attribute")
+	}
+	if graphql["status"] != float64(http.StatusOK) {
+		t.Errorf("Swo.Logger status = %v, want 200", graphql["status"])
+	}
+}
+
+func TestLoggerOption_DebugRedactsSecrets(t *testing.T) {
+	client, server, recorder := setupLogging(t, DebugOption(true))
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		sendGraphQLResponse(t, w, []byte(`{"data": {"createToken": {
+			"code": "200", "success": true, "message": "",
+			"token": {"id": "456", "name": "token", "token": "server-secret"}
+		}}}`))
+	})
+
+	_, _ = client.ApiTokenService().Create(context.Background(), CreateTokenInput{Name: "token"})
+
+	out := recorder.buf.String()
+	for _, secret := range []string{"secret-api-token", "server-secret"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("Swo.Logger wrote the secret %q: %s", secret, out)
+		}
+	}
+
+	records := recorder.records(t)
+	if recorder.find("http request", records) == nil || recorder.find("http response", records) == nil {
+		t.Errorf("Swo.Logger expected request and response dumps in debug mode, got %v", records)
+	}
+	if !strings.Contains(out, "Authorization: "+redacted) {
+		t.Errorf("Swo.Logger expected a redacted Authorization header: %s", out)
+	}
+}
+
+func TestLogger_SilentByDefault(t *testing.T) {
+	client, err := New("123456")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := client.logger.Handler().(discardHandler); !ok {
+		t.Errorf("Swo.Logger expected the default logger to discard records, got %T", client.logger.Handler())
+	}
+}
+
+func TestRedactJSON(t *testing.T) {
+	got := redactJSON([]byte(`{"variables": {"input": {"name": "n", "apiKey": "k", "settings": [{"password": "p", "webhookSecret": "s", "url": "u"}]}}}`))
+	want := `{"variables":{"input":{"apiKey":"[REDACTED]","name":"n","settings":[{"password":"[REDACTED]","url":"u","webhookSecret":"[REDACTED]"}]}}}`
+
+	if string(got) != want {
+		t.Errorf("redactJSON returned %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,182 @@
+package client
+
+import (
+	"context"
+)
+
+type MaintenanceWindowsService service
+
+type CreateMaintenanceWindowResult = createMaintenanceWindowMutationMaintenanceMutationsCreateMaintenanceWindow
+type UpdateMaintenanceWindowResult = updateMaintenanceWindowMutationMaintenanceMutationsUpdateMaintenanceWindow
+
+type ListMaintenanceWindowsResult = getMaintenanceWindowsMaintenanceQueriesMaintenanceWindowsMaintenanceWindowsResult
+type MaintenanceWindowsPaginator = Paginator[*ListMaintenanceWindowsResult, ReadMaintenanceWindowResult]
+type ReadMaintenanceWindowResult = getMaintenanceWindowsMaintenanceQueriesMaintenanceWindowsMaintenanceWindowsResultMaintenanceWindowsMaintenanceWindow
+type ReadMaintenanceWindowScheduleResult = getMaintenanceWindowsMaintenanceQueriesMaintenanceWindowsMaintenanceWindowsResultMaintenanceWindowsMaintenanceWindowSchedule
+type ReadMaintenanceWindowScopeResult = getMaintenanceWindowsMaintenanceQueriesMaintenanceWindowsMaintenanceWindowsResultMaintenanceWindowsMaintenanceWindowScope
+
+type MaintenanceWindowsCommunicator interface {
+	Create(context.Context, MaintenanceWindowInput) (*CreateMaintenanceWindowResult, error)
+	Read(context.Context, string) (*ReadMaintenanceWindowResult, error)
+	Update(context.Context, string, MaintenanceWindowInput) (*UpdateMaintenanceWindowResult, error)
+	Delete(context.Context, string) error
+	List(context.Context, *MaintenanceWindowFilterInput, *ListOptions) (*ListMaintenanceWindowsResult, error)
+	All(context.Context, *MaintenanceWindowFilterInput, *ListOptions) *MaintenanceWindowsPaginator
+	StartTestRun(context.Context, string) error
+	StopTestRun(context.Context, string) error
+}
+
+func newMaintenanceWindowsService(c *Client) *MaintenanceWindowsService {
+	return &MaintenanceWindowsService{c}
+}
+
+// Create creates a new maintenance window with the given input.
+func (s *MaintenanceWindowsService) Create(ctx context.Context, input MaintenanceWindowInput) (*CreateMaintenanceWindowResult, error) {
+	s.client.logger.DebugContext(ctx, "create maintenance window request", "name", input.Name)
+
+	if err := ValidateMaintenanceWindowSchedule(input.Schedule); err != nil {
+		return nil, err
+	}
+
+	resp, err := createMaintenanceWindowMutation(ctx, s.client.gql, input)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &resp.MaintenanceMutations.CreateMaintenanceWindow
+	s.client.logger.DebugContext(ctx, "create maintenance window success", "id", result.Id)
+
+	return result, nil
+}
+
+// Read returns the maintenance window identified by the given id.
+func (s *MaintenanceWindowsService) Read(ctx context.Context, id string) (*ReadMaintenanceWindowResult, error) {
+	s.client.logger.DebugContext(ctx, "read maintenance window request", "id", id)
+
+	resp, err := getMaintenanceWindows(ctx, s.client.gql, &MaintenanceWindowFilterInput{Id: &id}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	windows := resp.MaintenanceQueries.MaintenanceWindows.MaintenanceWindows
+	if len(windows) == 0 {
+		return nil, ErrNotFound
+	}
+
+	s.client.logger.DebugContext(ctx, "read maintenance window success", "id", id)
+	return &windows[0], nil
+}
+
+// Update replaces the maintenance window with the given id.
+func (s *MaintenanceWindowsService) Update(ctx context.Context, id string, input MaintenanceWindowInput) (*UpdateMaintenanceWindowResult, error) {
+	s.client.logger.DebugContext(ctx, "update maintenance window request", "id", id)
+
+	if err := ValidateMaintenanceWindowSchedule(input.Schedule); err != nil {
+		return nil, err
+	}
+
+	resp, err := updateMaintenanceWindowMutation(ctx, s.client.gql, id, input)
+	if err != nil {
+		return nil, err
+	}
+
+	result := resp.MaintenanceMutations.UpdateMaintenanceWindow
+	if result == nil {
+		s.client.logger.DebugContext(ctx, "maintenance window not found", "id", id)
+		return nil, ErrNotFound
+	}
+
+	s.client.logger.DebugContext(ctx, "update maintenance window success", "id", id)
+	return result, nil
+}
+
+// Delete deletes the maintenance window with the given id.
+func (s *MaintenanceWindowsService) Delete(ctx context.Context, id string) error {
+	s.client.logger.DebugContext(ctx, "delete maintenance window request", "id", id)
+
+	resp, err := deleteMaintenanceWindowMutation(ctx, s.client.gql, id)
+	if err != nil {
+		return err
+	}
+
+	idPtr := resp.MaintenanceMutations.DeleteMaintenanceWindow
+	if idPtr == nil || *idPtr != id {
+		s.client.logger.DebugContext(ctx, "maintenance window not found", "id", id)
+		return ErrNotFound
+	}
+
+	s.client.logger.DebugContext(ctx, "delete maintenance window success", "id", id)
+	return nil
+}
+
+// List returns a single page of maintenance windows matching the optional filter. The API does
+// not sort maintenance windows, so opts.SortBy is ignored.
+func (s *MaintenanceWindowsService) List(ctx context.Context, filter *MaintenanceWindowFilterInput, opts *ListOptions) (*ListMaintenanceWindowsResult, error) {
+	s.client.logger.DebugContext(ctx, "list maintenance windows request")
+
+	resp, err := getMaintenanceWindows(ctx, s.client.gql, filter, opts.paging())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &resp.MaintenanceQueries.MaintenanceWindows
+	s.client.logger.DebugContext(ctx, "list maintenance windows success", "count", len(result.MaintenanceWindows))
+
+	return result, nil
+}
+
+// All returns a paginator over every maintenance window matching the optional filter.
+func (s *MaintenanceWindowsService) All(ctx context.Context, filter *MaintenanceWindowFilterInput, opts *ListOptions) *MaintenanceWindowsPaginator {
+	return NewPaginator(ctx,
+		func(ctx context.Context, paging *PagingInput) (*ListMaintenanceWindowsResult, error) {
+			return s.List(ctx, filter, &ListOptions{Paging: paging})
+		},
+		func(page *ListMaintenanceWindowsResult) []ReadMaintenanceWindowResult {
+			return page.MaintenanceWindows
+		},
+		opts.paginatorOptions()...)
+}
+
+// StartTestRun starts a run of the maintenance window with the given id outside of its schedule.
+func (s *MaintenanceWindowsService) StartTestRun(ctx context.Context, id string) error {
+	s.client.logger.DebugContext(ctx, "start maintenance window test run request", "id", id)
+
+	_, err := doMutate(ctx,
+		func(ctx context.Context) (*startMaintenanceWindowRunMutationResponse, error) {
+			return startMaintenanceWindowRunMutation(ctx, s.client.gql, id)
+		},
+		func(resp *startMaintenanceWindowRunMutationResponse) error {
+			if !resp.MaintenanceMutations.StartMaintenanceWindowRunForTest {
+				return mutateUnknownError("start maintenance window test run failed")
+			}
+			return nil
+		})
+	if err != nil {
+		return err
+	}
+
+	s.client.logger.DebugContext(ctx, "start maintenance window test run success", "id", id)
+	return nil
+}
+
+// StopTestRun stops a run of the maintenance window with the given id that was started by StartTestRun.
+func (s *MaintenanceWindowsService) StopTestRun(ctx context.Context, id string) error {
+	s.client.logger.DebugContext(ctx, "stop maintenance window test run request", "id", id)
+
+	_, err := doMutate(ctx,
+		func(ctx context.Context) (*stopMaintenanceWindowRunMutationResponse, error) {
+			return stopMaintenanceWindowRunMutation(ctx, s.client.gql, id)
+		},
+		func(resp *stopMaintenanceWindowRunMutationResponse) error {
+			if !resp.MaintenanceMutations.StopMaintenanceWindowRunForTest {
+				return mutateUnknownError("stop maintenance window test run failed")
+			}
+			return nil
+		})
+	if err != nil {
+		return err
+	}
+
+	s.client.logger.DebugContext(ctx, "stop maintenance window test run success", "id", id)
+	return nil
+}
@@ -0,0 +1,412 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	mockMaintenanceWindowStart = time.Date(2030, time.January, 7, 22, 0, 0, 0, time.UTC)
+	mockMaintenanceWindowEnd   = mockMaintenanceWindowStart.Add(2 * time.Hour)
+	mockMaintenanceWindowInput = func() MaintenanceWindowInput {
+		return MaintenanceWindowInput{
+			Name:        "swo-client-go - maintenance window",
+			Description: Ptr("maintenance window description"),
+			Schedule: MaintenanceWindowRecurring(mockMaintenanceWindowStart, mockMaintenanceWindowEnd,
+				MaintenanceWindowOnDaysOfWeek(time.Monday, time.Thursday)),
+			Scope: MaintenanceWindowScopeInput{
+				Entities: []MaintenanceWindowScopeEntityScopeInput{
+					{Types: []string{"Website"}, Query: Ptr("tags.env:prod")},
+				},
+			},
+		}
+	}
+	mockReadMaintenanceWindowResult = func(id string) ReadMaintenanceWindowResult {
+		return ReadMaintenanceWindowResult{
+			Id:             id,
+			OrganizationId: "140638900734749696",
+			Name:           "swo-client-go - maintenance window",
+			Description:    Ptr("maintenance window description"),
+			State:          MaintenanceWindowStatusScheduled,
+			CurrentRun: getMaintenanceWindowsMaintenanceQueriesMaintenanceWindowsMaintenanceWindowsResultMaintenanceWindowsMaintenanceWindowCurrentRunMaintenanceWindowRun{
+				Start: "2030-01-07T22:00:00Z",
+				End:   "2030-01-08T00:00:00Z",
+			},
+			Schedule: ReadMaintenanceWindowScheduleResult{
+				Start: "2030-01-07T22:00:00Z",
+				End:   "2030-01-08T00:00:00Z",
+				Recurrence: &getMaintenanceWindowsMaintenanceQueriesMaintenanceWindowsMaintenanceWindowsResultMaintenanceWindowsMaintenanceWindowScheduleRecurrenceMaintenanceWindowRecurrence{
+					Unit:              Ptr(MaintenanceWindowRecurrenceUnitDayOfWeek),
+					RepeatOnDayOfWeek: []int{1, 4},
+				},
+			},
+			Scope: ReadMaintenanceWindowScopeResult{
+				Entities: []getMaintenanceWindowsMaintenanceQueriesMaintenanceWindowsMaintenanceWindowsResultMaintenanceWindowsMaintenanceWindowScopeEntitiesMaintenanceWindowScopeEntityScope{
+					{Types: []string{"Website"}, Query: Ptr("tags.env:prod")},
+				},
+			},
+			CreatedAt: "2029-12-01T10:00:00Z",
+		}
+	}
+)
+
+func TestSwoService_CreateMaintenanceWindow(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	input := mockMaintenanceWindowInput()
+	id := uuid.NewString()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__createMaintenanceWindowMutationInput](r)
+		if err != nil {
+			t.Errorf("Swo.CreateMaintenanceWindow error: %v", err)
+		}
+
+		got := gqlInput.Window
+		want := input
+
+		if !testObjects(t, got, want) {
+			t.Errorf("Request got = %+v, want = %+v", got, want)
+		}
+
+		sendGraphQLResponse(t, w, createMaintenanceWindowMutationResponse{
+			MaintenanceMutations: createMaintenanceWindowMutationMaintenanceMutations{
+				CreateMaintenanceWindow: CreateMaintenanceWindowResult{
+					Id:          id,
+					Name:        got.Name,
+					Description: got.Description,
+					State:       MaintenanceWindowStatusScheduled,
+				},
+			},
+		})
+	})
+
+	got, err := client.MaintenanceWindowsService().Create(ctx, input)
+	if err != nil {
+		t.Errorf("Swo.CreateMaintenanceWindow returned error: %v", err)
+	}
+
+	want := &CreateMaintenanceWindowResult{
+		Id:          id,
+		Name:        input.Name,
+		Description: input.Description,
+		State:       MaintenanceWindowStatusScheduled,
+	}
+
+	if !testObjects(t, got, want) {
+		t.Errorf("Swo.CreateMaintenanceWindow returned %+v, want %+v", got, want)
+	}
+}
+
+func TestSwoService_ReadMaintenanceWindow(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	id := uuid.NewString()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__getMaintenanceWindowsInput](r)
+		if err != nil {
+			t.Errorf("Swo.ReadMaintenanceWindow error: %v", err)
+		}
+
+		got := *gqlInput.Filter.Id
+		want := id
+
+		if !testObjects(t, got, want) {
+			t.Errorf("Request got = %+v, want = %+v", got, want)
+		}
+
+		sendGraphQLResponse(t, w, getMaintenanceWindowsResponse{
+			MaintenanceQueries: getMaintenanceWindowsMaintenanceQueries{
+				MaintenanceWindows: ListMaintenanceWindowsResult{
+					MaintenanceWindows: []ReadMaintenanceWindowResult{mockReadMaintenanceWindowResult(got)},
+				},
+			},
+		})
+	})
+
+	got, err := client.MaintenanceWindowsService().Read(ctx, id)
+	if err != nil {
+		t.Errorf("Swo.ReadMaintenanceWindow returned error: %v", err)
+	}
+
+	want := mockReadMaintenanceWindowResult(id)
+
+	if !testObjects(t, got, &want) {
+		t.Errorf("Swo.ReadMaintenanceWindow returned %+v, want %+v", got, want)
+	}
+}
+
+func TestSwoService_ReadMaintenanceWindowNotFound(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		sendGraphQLResponse(t, w, getMaintenanceWindowsResponse{})
+	})
+
+	_, err := client.MaintenanceWindowsService().Read(ctx, "123")
+	if err != ErrNotFound {
+		t.Errorf("Swo.ReadMaintenanceWindowNotFound returned %v, want %v", err, ErrNotFound)
+	}
+}
+
+func TestSwoService_UpdateMaintenanceWindow(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	input := mockMaintenanceWindowInput()
+	id := uuid.NewString()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__updateMaintenanceWindowMutationInput](r)
+		if err != nil {
+			t.Errorf("Swo.UpdateMaintenanceWindow error: %v", err)
+		}
+
+		if !testObjects(t, gqlInput.Id, id) {
+			t.Errorf("Request got = %+v, want = %+v", gqlInput.Id, id)
+		}
+		if !testObjects(t, gqlInput.Window, input) {
+			t.Errorf("Request got = %+v, want = %+v", gqlInput.Window, input)
+		}
+
+		sendGraphQLResponse(t, w, updateMaintenanceWindowMutationResponse{
+			MaintenanceMutations: updateMaintenanceWindowMutationMaintenanceMutations{
+				UpdateMaintenanceWindow: &UpdateMaintenanceWindowResult{
+					Id:   gqlInput.Id,
+					Name: gqlInput.Window.Name,
+				},
+			},
+		})
+	})
+
+	got, err := client.MaintenanceWindowsService().Update(ctx, id, input)
+	if err != nil {
+		t.Errorf("Swo.UpdateMaintenanceWindow returned error: %v", err)
+	}
+
+	want := &UpdateMaintenanceWindowResult{
+		Id:   id,
+		Name: input.Name,
+	}
+
+	if !testObjects(t, got, want) {
+		t.Errorf("Swo.UpdateMaintenanceWindow returned %+v, want %+v", got, want)
+	}
+}
+
+func TestSwoService_DeleteMaintenanceWindow(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	id := uuid.NewString()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__deleteMaintenanceWindowMutationInput](r)
+		if err != nil {
+			t.Errorf("Swo.DeleteMaintenanceWindow error: %v", err)
+		}
+
+		if !testObjects(t, gqlInput.Id, id) {
+			t.Errorf("Swo.DeleteMaintenanceWindow: Request got = %+v, want %+v", gqlInput.Id, id)
+		}
+
+		sendGraphQLResponse(t, w, deleteMaintenanceWindowMutationResponse{
+			MaintenanceMutations: deleteMaintenanceWindowMutationMaintenanceMutations{
+				DeleteMaintenanceWindow: &gqlInput.Id,
+			},
+		})
+	})
+
+	if err := client.MaintenanceWindowsService().Delete(ctx, id); err != nil {
+		t.Errorf("Swo.DeleteMaintenanceWindow returned error: %v", err)
+	}
+}
+
+func TestSwoService_ListMaintenanceWindows(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	paging := &PagingInput{First: Ptr(2), After: Ptr("cursor-1")}
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__getMaintenanceWindowsInput](r)
+		if err != nil {
+			t.Errorf("Swo.ListMaintenanceWindows error: %v", err)
+		}
+
+		if !testObjects(t, gqlInput.Paging, paging) {
+			t.Errorf("Request got = %+v, want = %+v", gqlInput.Paging, paging)
+		}
+
+		sendGraphQLResponse(t, w, getMaintenanceWindowsResponse{
+			MaintenanceQueries: getMaintenanceWindowsMaintenanceQueries{
+				MaintenanceWindows: ListMaintenanceWindowsResult{
+					MaintenanceWindows: []ReadMaintenanceWindowResult{
+						mockReadMaintenanceWindowResult("1"),
+						mockReadMaintenanceWindowResult("2"),
+					},
+					PageInfo: PageInfo{
+						EndCursor:   Ptr("cursor-2"),
+						HasNextPage: true,
+					},
+					TotalRecords: Ptr(3),
+				},
+			},
+		})
+	})
+
+	got, err := client.MaintenanceWindowsService().List(ctx, nil, &ListOptions{Paging: paging})
+	if err != nil {
+		t.Errorf("Swo.ListMaintenanceWindows returned error: %v", err)
+		return
+	}
+
+	if len(got.MaintenanceWindows) != 2 || !got.PageInfo.HasNextPage || *got.PageInfo.EndCursor != "cursor-2" {
+		t.Errorf("Swo.ListMaintenanceWindows returned unexpected page %+v", got)
+	}
+}
+
+func TestSwoService_MaintenanceWindowTestRun(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlRequest, err := getGraphQLInput[map[string]any](r)
+		if err != nil {
+			t.Errorf("Swo.MaintenanceWindowTestRun error: %v", err)
+		}
+
+		if (*gqlRequest)["id"] != "123" {
+			t.Errorf("Request got = %+v, want id = 123", *gqlRequest)
+		}
+
+		sendGraphQLResponse(t, w, []byte(`{"data":{"maintenanceMutations":{
+			"startMaintenanceWindowRunForTest":true,
+			"stopMaintenanceWindowRunForTest":true}}}`))
+	})
+
+	if err := client.MaintenanceWindowsService().StartTestRun(ctx, "123"); err != nil {
+		t.Errorf("Swo.StartTestRun returned error: %v", err)
+	}
+	if err := client.MaintenanceWindowsService().StopTestRun(ctx, "123"); err != nil {
+		t.Errorf("Swo.StopTestRun returned error: %v", err)
+	}
+}
+
+func TestSwoService_MaintenanceWindowTestRunFailed(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		sendGraphQLResponse(t, w, []byte(`{"data":{"maintenanceMutations":{
+			"startMaintenanceWindowRunForTest":false,
+			"stopMaintenanceWindowRunForTest":false}}}`))
+	})
+
+	err := client.MaintenanceWindowsService().StartTestRun(ctx, "123")
+	var apiErr *APIError
+	if !errors.Is(err, ErrUnknown) || !errors.As(err, &apiErr) {
+		t.Fatalf("Swo.StartTestRun returned %v, want an *APIError wrapping ErrUnknown", err)
+	}
+	if want := "start maintenance window test run failed. message=unknown error"; err.Error() != want {
+		t.Errorf("Swo.StartTestRun returned %q, want %q", err, want)
+	}
+	if apiErr.Operation != "startMaintenanceWindowRunMutation" {
+		t.Errorf("Swo.StartTestRun error operation = %q, want startMaintenanceWindowRunMutation", apiErr.Operation)
+	}
+
+	if err := client.MaintenanceWindowsService().StopTestRun(ctx, "123"); !errors.Is(err, ErrUnknown) {
+		t.Errorf("Swo.StopTestRun returned %v, want ErrUnknown", err)
+	}
+}
+
+func TestSwoService_MaintenanceWindowServerErrors(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", httpErrorResponse)
+
+	input := mockMaintenanceWindowInput()
+
+	_, err := client.MaintenanceWindowsService().Create(ctx, input)
+	if err == nil {
+		t.Error("Swo.MaintenanceWindowServerErrors expected an error response")
+	}
+	_, err = client.MaintenanceWindowsService().Read(ctx, "123")
+	if err == nil {
+		t.Error("Swo.MaintenanceWindowServerErrors expected an error response")
+	}
+	_, err = client.MaintenanceWindowsService().Update(ctx, "123", input)
+	if err == nil {
+		t.Error("Swo.MaintenanceWindowServerErrors expected an error response")
+	}
+	err = client.MaintenanceWindowsService().Delete(ctx, "123")
+	if err == nil {
+		t.Error("Swo.MaintenanceWindowServerErrors expected an error response")
+	}
+	_, err = client.MaintenanceWindowsService().List(ctx, nil, nil)
+	if err == nil {
+		t.Error("Swo.MaintenanceWindowServerErrors expected an error response")
+	}
+	err = client.MaintenanceWindowsService().StartTestRun(ctx, "123")
+	if err == nil {
+		t.Error("Swo.MaintenanceWindowServerErrors expected an error response")
+	}
+}
+
+func TestMaintenanceWindowSchedule(t *testing.T) {
+	until := mockMaintenanceWindowStart.AddDate(0, 3, 0)
+
+	got := MaintenanceWindowRecurring(mockMaintenanceWindowStart, mockMaintenanceWindowEnd,
+		MaintenanceWindowEvery(2, MaintenanceWindowRecurrenceUnitWeekly).Until(until))
+
+	want := MaintenanceWindowScheduleInput{
+		NextStart: "2030-01-07T22:00:00Z",
+		NextEnd:   "2030-01-08T00:00:00Z",
+		Recurrence: &MaintenanceWindowRecurrenceInput{
+			Unit:          Ptr(MaintenanceWindowRecurrenceUnitWeekly),
+			Frequency:     Ptr(2),
+			RecurrenceEnd: Ptr("2030-04-07T22:00:00Z"),
+		},
+	}
+
+	if !testObjects(t, got, want) {
+		t.Errorf("MaintenanceWindowRecurring returned %+v, want %+v", got, want)
+	}
+
+	if err := ValidateMaintenanceWindowSchedule(got); err != nil {
+		t.Errorf("ValidateMaintenanceWindowSchedule returned error: %v", err)
+	}
+}
+
+func TestValidateMaintenanceWindowSchedule(t *testing.T) {
+	dayOfWeekWithFrequency := MaintenanceWindowOnDaysOfWeek(time.Monday)
+	dayOfWeekWithFrequency.Frequency = Ptr(1)
+
+	tests := map[string]MaintenanceWindowScheduleInput{
+		"end before start": MaintenanceWindowOnce(mockMaintenanceWindowEnd, mockMaintenanceWindowStart),
+		"bad timestamp":    {NextStart: "tomorrow", NextEnd: "2030-01-08T00:00:00Z"},
+		"zero frequency": MaintenanceWindowRecurring(mockMaintenanceWindowStart, mockMaintenanceWindowEnd,
+			MaintenanceWindowEvery(0, MaintenanceWindowRecurrenceUnitDaily)),
+		"no days of week": MaintenanceWindowRecurring(mockMaintenanceWindowStart, mockMaintenanceWindowEnd,
+			MaintenanceWindowOnDaysOfWeek()),
+		"day of week with frequency": MaintenanceWindowRecurring(mockMaintenanceWindowStart, mockMaintenanceWindowEnd,
+			dayOfWeekWithFrequency),
+		"recurrence ends before start": MaintenanceWindowRecurring(mockMaintenanceWindowStart, mockMaintenanceWindowEnd,
+			MaintenanceWindowEvery(1, MaintenanceWindowRecurrenceUnitDaily).Until(mockMaintenanceWindowStart.AddDate(0, 0, -1))),
+	}
+
+	for name, schedule := range tests {
+		if err := ValidateMaintenanceWindowSchedule(schedule); err == nil {
+			t.Errorf("ValidateMaintenanceWindowSchedule(%s) expected an error", name)
+		}
+	}
+}
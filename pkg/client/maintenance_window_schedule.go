@@ -0,0 +1,110 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// The maintenance api exchanges all schedule timestamps in ISO-8601 format.
+const MaintenanceWindowTimeFormat = time.RFC3339
+
+// MaintenanceWindowOnce returns a one-time schedule running from start to end.
+func MaintenanceWindowOnce(start time.Time, end time.Time) MaintenanceWindowScheduleInput {
+	return MaintenanceWindowScheduleInput{
+		NextStart: start.UTC().Format(MaintenanceWindowTimeFormat),
+		NextEnd:   end.UTC().Format(MaintenanceWindowTimeFormat),
+	}
+}
+
+// MaintenanceWindowRecurring returns a schedule whose first run is from start to end and which
+// then repeats according to the given recurrence.
+func MaintenanceWindowRecurring(start time.Time, end time.Time, recurrence MaintenanceWindowRecurrenceInput) MaintenanceWindowScheduleInput {
+	schedule := MaintenanceWindowOnce(start, end)
+	schedule.Recurrence = &recurrence
+
+	return schedule
+}
+
+// MaintenanceWindowEvery returns a recurrence that repeats every frequency units, e.g. every 2 weeks.
+// Use MaintenanceWindowOnDaysOfWeek for the DAY_OF_WEEK unit.
+func MaintenanceWindowEvery(frequency int, unit MaintenanceWindowRecurrenceUnit) MaintenanceWindowRecurrenceInput {
+	return MaintenanceWindowRecurrenceInput{
+		Unit:      &unit,
+		Frequency: &frequency,
+	}
+}
+
+// MaintenanceWindowOnDaysOfWeek returns a recurrence that repeats on each of the given weekdays.
+func MaintenanceWindowOnDaysOfWeek(days ...time.Weekday) MaintenanceWindowRecurrenceInput {
+	repeatOn := make([]int, 0, len(days))
+	for _, day := range days {
+		repeatOn = append(repeatOn, int(day))
+	}
+
+	return MaintenanceWindowRecurrenceInput{
+		Unit:              Ptr(MaintenanceWindowRecurrenceUnitDayOfWeek),
+		RepeatOnDayOfWeek: repeatOn,
+	}
+}
+
+// Until returns a copy of the recurrence whose last run happens no later than end.
+func (r MaintenanceWindowRecurrenceInput) Until(end time.Time) MaintenanceWindowRecurrenceInput {
+	r.RecurrenceEnd = Ptr(end.UTC().Format(MaintenanceWindowTimeFormat))
+	return r
+}
+
+// ValidateMaintenanceWindowSchedule checks the schedule for errors that would otherwise only
+// be reported by the server.
+func ValidateMaintenanceWindowSchedule(schedule MaintenanceWindowScheduleInput) error {
+	start, err := time.Parse(MaintenanceWindowTimeFormat, schedule.NextStart)
+	if err != nil {
+		return fmt.Errorf("invalid maintenance window start: %w", err)
+	}
+
+	end, err := time.Parse(MaintenanceWindowTimeFormat, schedule.NextEnd)
+	if err != nil {
+		return fmt.Errorf("invalid maintenance window end: %w", err)
+	}
+
+	if !end.After(start) {
+		return errors.New("maintenance window end must be after start")
+	}
+
+	recurrence := schedule.Recurrence
+	if recurrence == nil {
+		return nil
+	}
+
+	if recurrence.Unit == nil {
+		return errors.New("maintenance window recurrence unit is required")
+	}
+
+	if *recurrence.Unit == MaintenanceWindowRecurrenceUnitDayOfWeek {
+		if recurrence.Frequency != nil {
+			return errors.New("maintenance window recurrence frequency is not used with DAY_OF_WEEK")
+		}
+		if len(recurrence.RepeatOnDayOfWeek) == 0 {
+			return errors.New("maintenance window recurrence requires at least one day of week")
+		}
+		for _, day := range recurrence.RepeatOnDayOfWeek {
+			if day < int(time.Sunday) || day > int(time.Saturday) {
+				return fmt.Errorf("maintenance window recurrence day of week out of range: %d", day)
+			}
+		}
+	} else if recurrence.Frequency == nil || *recurrence.Frequency < 1 {
+		return errors.New("maintenance window recurrence frequency must be at least 1")
+	}
+
+	if recurrence.RecurrenceEnd != nil {
+		recurrenceEnd, err := time.Parse(MaintenanceWindowTimeFormat, *recurrence.RecurrenceEnd)
+		if err != nil {
+			return fmt.Errorf("invalid maintenance window recurrence end: %w", err)
+		}
+		if recurrenceEnd.Before(start) {
+			return errors.New("maintenance window recurrence end must not be before start")
+		}
+	}
+
+	return nil
+}
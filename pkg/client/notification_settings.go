@@ -0,0 +1,284 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"slices"
+)
+
+// The notification types that have typed settings, spelled as the API returns them in the type of a
+// notification and the fields of NotificationServicesGroupCount.
+const (
+	NotificationTypeEmail      = "email"
+	NotificationTypeSlack      = "slack"
+	NotificationTypePagerDuty  = "pagerduty"
+	NotificationTypeWebhook    = "webhook"
+	NotificationTypeMsTeams    = "msTeams"
+	NotificationTypeOpsGenie   = "opsgenie"
+	NotificationTypeServiceNow = "servicenow"
+)
+
+// NotificationSettings is implemented by the typed settings of each notification type. The
+// settings marshal to the JSON expected by the settings field of a notification.
+type NotificationSettings interface {
+	// NotificationType returns the notification type the settings belong to, e.g. slack.
+	NotificationType() string
+	// Validate checks the settings for missing and malformed fields. It returns nil when no
+	// errors are found.
+	Validate() []ValidationError
+}
+
+// EmailAddress is a recipient of an email notification.
+type EmailAddress struct {
+	Id    *string `json:"id,omitempty"`
+	Email string  `json:"email"`
+}
+
+// EmailSettings sends notifications to a list of email addresses.
+type EmailSettings struct {
+	Addresses []EmailAddress `json:"addresses"`
+}
+
+func (s *EmailSettings) NotificationType() string { return NotificationTypeEmail }
+
+func (s *EmailSettings) Validate() []ValidationError {
+	var errs []ValidationError
+	if len(s.Addresses) == 0 {
+		errs = append(errs, ValidationError{Path: "$.settings.addresses", Message: "must contain at least one address"})
+	}
+	for i, address := range s.Addresses {
+		path := fmt.Sprintf("$.settings.addresses[%d].email", i)
+		if address.Email == "" {
+			errs = appendRequired(errs, path, address.Email)
+		} else if _, err := mail.ParseAddress(address.Email); err != nil {
+			errs = append(errs, ValidationError{Path: path, Message: fmt.Sprintf("%q is not an email address", address.Email)})
+		}
+	}
+	return errs
+}
+
+// SlackSettings posts notifications to a Slack incoming webhook.
+type SlackSettings struct {
+	Url string `json:"url"`
+}
+
+func (s *SlackSettings) NotificationType() string { return NotificationTypeSlack }
+
+func (s *SlackSettings) Validate() []ValidationError {
+	return appendURL(nil, "$.settings.url", s.Url)
+}
+
+// PagerDutySettings sends notifications to a PagerDuty service integration.
+type PagerDutySettings struct {
+	RoutingKey string  `json:"routingKey"`
+	Summary    *string `json:"summary,omitempty"`
+	DedupKey   *string `json:"dedupKey,omitempty"`
+}
+
+func (s *PagerDutySettings) NotificationType() string { return NotificationTypePagerDuty }
+
+func (s *PagerDutySettings) Validate() []ValidationError {
+	return appendRequired(nil, "$.settings.routingKey", s.RoutingKey)
+}
+
+// The authentication types supported by webhook notifications.
+const (
+	WebhookAuthNone   = "none"
+	WebhookAuthBasic  = "basic"
+	WebhookAuthHeader = "token"
+)
+
+// The HTTP methods supported by webhook notifications.
+var webhookMethods = []string{"GET", "POST"}
+
+// WebhookSettings sends notifications to an HTTP endpoint.
+type WebhookSettings struct {
+	Url             string  `json:"url"`
+	Method          string  `json:"method"`
+	AuthType        *string `json:"authType,omitempty"`
+	AuthUsername    *string `json:"authUsername,omitempty"`
+	AuthPassword    *string `json:"authPassword,omitempty"`
+	AuthHeaderName  *string `json:"authHeaderName,omitempty"`
+	AuthHeaderValue *string `json:"authHeaderValue,omitempty"`
+}
+
+func (s *WebhookSettings) NotificationType() string { return NotificationTypeWebhook }
+
+func (s *WebhookSettings) Validate() []ValidationError {
+	errs := appendURL(nil, "$.settings.url", s.Url)
+	if !slices.Contains(webhookMethods, s.Method) {
+		errs = append(errs, ValidationError{Path: "$.settings.method", Message: fmt.Sprintf("%q is not one of %v", s.Method, webhookMethods)})
+	}
+
+	if s.AuthType == nil {
+		return errs
+	}
+	switch *s.AuthType {
+	case WebhookAuthNone:
+	case WebhookAuthBasic:
+		errs = appendRequired(errs, "$.settings.authUsername", deref(s.AuthUsername))
+		errs = appendRequired(errs, "$.settings.authPassword", deref(s.AuthPassword))
+	case WebhookAuthHeader:
+		errs = appendRequired(errs, "$.settings.authHeaderName", deref(s.AuthHeaderName))
+		errs = appendRequired(errs, "$.settings.authHeaderValue", deref(s.AuthHeaderValue))
+	default:
+		errs = append(errs, ValidationError{Path: "$.settings.authType", Message: fmt.Sprintf("%q is not one of %v", *s.AuthType,
+			[]string{WebhookAuthNone, WebhookAuthBasic, WebhookAuthHeader})})
+	}
+	return errs
+}
+
+// MsTeamsSettings posts notifications to a Microsoft Teams incoming webhook.
+type MsTeamsSettings struct {
+	Url string `json:"url"`
+}
+
+func (s *MsTeamsSettings) NotificationType() string { return NotificationTypeMsTeams }
+
+func (s *MsTeamsSettings) Validate() []ValidationError {
+	return appendURL(nil, "$.settings.url", s.Url)
+}
+
+// OpsGenieSettings creates OpsGenie alerts.
+type OpsGenieSettings struct {
+	// The OpsGenie API host, e.g. api.opsgenie.com or api.eu.opsgenie.com.
+	HostName   string  `json:"hostName"`
+	ApiKey     string  `json:"apiKey"`
+	Recipients *string `json:"recipients,omitempty"`
+	Teams      *string `json:"teams,omitempty"`
+	Tags       *string `json:"tags,omitempty"`
+}
+
+func (s *OpsGenieSettings) NotificationType() string { return NotificationTypeOpsGenie }
+
+func (s *OpsGenieSettings) Validate() []ValidationError {
+	errs := appendRequired(nil, "$.settings.hostName", s.HostName)
+	return appendRequired(errs, "$.settings.apiKey", s.ApiKey)
+}
+
+// ServiceNowSettings creates ServiceNow incidents.
+type ServiceNowSettings struct {
+	// The ServiceNow instance name, e.g. mycompany for mycompany.service-now.com.
+	Instance string `json:"instance"`
+	AppToken string `json:"appToken"`
+}
+
+func (s *ServiceNowSettings) NotificationType() string { return NotificationTypeServiceNow }
+
+func (s *ServiceNowSettings) Validate() []ValidationError {
+	errs := appendRequired(nil, "$.settings.instance", s.Instance)
+	return appendRequired(errs, "$.settings.appToken", s.AppToken)
+}
+
+// newNotificationSettings returns empty typed settings for the notification type.
+func newNotificationSettings(notificationType string) (NotificationSettings, error) {
+	switch notificationType {
+	case NotificationTypeEmail:
+		return &EmailSettings{}, nil
+	case NotificationTypeSlack:
+		return &SlackSettings{}, nil
+	case NotificationTypePagerDuty:
+		return &PagerDutySettings{}, nil
+	case NotificationTypeWebhook:
+		return &WebhookSettings{}, nil
+	case NotificationTypeMsTeams:
+		return &MsTeamsSettings{}, nil
+	case NotificationTypeOpsGenie:
+		return &OpsGenieSettings{}, nil
+	case NotificationTypeServiceNow:
+		return &ServiceNowSettings{}, nil
+	default:
+		return nil, fmt.Errorf("notification type %q has no typed settings", notificationType)
+	}
+}
+
+// DecodeNotificationSettings converts the raw settings of a notification to the typed settings of
+// the notification type.
+func DecodeNotificationSettings(notificationType string, settings any) (NotificationSettings, error) {
+	typed, err := newNotificationSettings(notificationType)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(settings)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, typed); err != nil {
+		return nil, fmt.Errorf("decode %s notification settings: %w", notificationType, err)
+	}
+
+	return typed, nil
+}
+
+// TypedSettings decodes the settings of the notification into the settings struct of its type,
+// e.g. *SlackSettings for slack notifications.
+func (r *ReadNotificationResult) TypedSettings() (NotificationSettings, error) {
+	if r.Settings == nil {
+		return nil, fmt.Errorf("notification %s has no settings", r.Id)
+	}
+	return DecodeNotificationSettings(r.Type, *r.Settings)
+}
+
+// NewCreateNotificationInput returns the input to create a notification with the given settings.
+// The settings are validated first, and the returned error wraps ErrValidation if they are invalid.
+func NewCreateNotificationInput(title string, description *string, settings NotificationSettings) (CreateNotificationInput, error) {
+	if err := validateNotificationSettings(settings); err != nil {
+		return CreateNotificationInput{}, err
+	}
+
+	return CreateNotificationInput{
+		Type:        settings.NotificationType(),
+		Title:       title,
+		Description: description,
+		Settings:    settings,
+	}, nil
+}
+
+// NewUpdateNotificationSettings returns the settings field of an UpdateNotificationInput. The
+// settings are validated first, and the returned error wraps ErrValidation if they are invalid.
+func NewUpdateNotificationSettings(settings NotificationSettings) (*any, error) {
+	if err := validateNotificationSettings(settings); err != nil {
+		return nil, err
+	}
+
+	var value any = settings
+	return &value, nil
+}
+
+func validateNotificationSettings(settings NotificationSettings) error {
+	if err := joinValidationErrors(settings.Validate()); err != nil {
+		return fmt.Errorf("%s notification settings: %w", settings.NotificationType(), err)
+	}
+	return nil
+}
+
+// appendRequired adds an error if the value is empty.
+func appendRequired(errs []ValidationError, path string, value string) []ValidationError {
+	if value == "" {
+		errs = append(errs, ValidationError{Path: path, Message: "is required"})
+	}
+	return errs
+}
+
+// appendURL adds an error if the value is not an absolute http or https URL.
+func appendURL(errs []ValidationError, path string, value string) []ValidationError {
+	if value == "" {
+		return append(errs, ValidationError{Path: path, Message: "is required"})
+	}
+
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		errs = append(errs, ValidationError{Path: path, Message: fmt.Sprintf("%q is not an http or https URL", value)})
+	}
+	return errs
+}
+
+func deref(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
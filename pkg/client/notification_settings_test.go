@@ -0,0 +1,152 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestNotificationSettings_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings NotificationSettings
+		want     []ValidationError
+	}{
+		{
+			name:     "valid email",
+			settings: &EmailSettings{Addresses: []EmailAddress{{Email: "ops@example.com"}}},
+		},
+		{
+			name:     "invalid email",
+			settings: &EmailSettings{Addresses: []EmailAddress{{Email: "ops"}, {}}},
+			want: []ValidationError{
+				{Path: "$.settings.addresses[0].email", Message: `"ops" is not an email address`},
+				{Path: "$.settings.addresses[1].email", Message: "is required"},
+			},
+		},
+		{
+			name:     "no email addresses",
+			settings: &EmailSettings{},
+			want:     []ValidationError{{Path: "$.settings.addresses", Message: "must contain at least one address"}},
+		},
+		{
+			name:     "valid slack",
+			settings: &SlackSettings{Url: "https://hooks.slack.com/services/T0/B0/X"},
+		},
+		{
+			name:     "slack url",
+			settings: &SlackSettings{Url: "hooks.slack.com/services"},
+			want:     []ValidationError{{Path: "$.settings.url", Message: `"hooks.slack.com/services" is not an http or https URL`}},
+		},
+		{
+			name:     "pagerduty",
+			settings: &PagerDutySettings{},
+			want:     []ValidationError{{Path: "$.settings.routingKey", Message: "is required"}},
+		},
+		{
+			name:     "valid webhook",
+			settings: &WebhookSettings{Url: "https://example.com/hook", Method: "POST", AuthType: Ptr(WebhookAuthNone)},
+		},
+		{
+			name:     "webhook method and basic auth",
+			settings: &WebhookSettings{Url: "https://example.com/hook", Method: "PUT", AuthType: Ptr(WebhookAuthBasic), AuthUsername: Ptr("user")},
+			want: []ValidationError{
+				{Path: "$.settings.method", Message: `"PUT" is not one of [GET POST]`},
+				{Path: "$.settings.authPassword", Message: "is required"},
+			},
+		},
+		{
+			name:     "ms teams",
+			settings: &MsTeamsSettings{},
+			want:     []ValidationError{{Path: "$.settings.url", Message: "is required"}},
+		},
+		{
+			name:     "opsgenie",
+			settings: &OpsGenieSettings{HostName: "api.opsgenie.com"},
+			want:     []ValidationError{{Path: "$.settings.apiKey", Message: "is required"}},
+		},
+		{
+			name:     "servicenow",
+			settings: &ServiceNowSettings{AppToken: "token"},
+			want:     []ValidationError{{Path: "$.settings.instance", Message: "is required"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.settings.Validate(); !testObjects(t, got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewCreateNotificationInput(t *testing.T) {
+	settings := &WebhookSettings{Url: "https://example.com/hook", Method: "POST"}
+
+	got, err := NewCreateNotificationInput("deploys", nil, settings)
+	if err != nil {
+		t.Fatalf("NewCreateNotificationInput returned error: %v", err)
+	}
+
+	want := CreateNotificationInput{Type: NotificationTypeWebhook, Title: "deploys", Settings: settings}
+	if !testObjects(t, got, want) {
+		t.Errorf("NewCreateNotificationInput returned %+v, want %+v", got, want)
+	}
+	data, err := json.Marshal(got.Settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"url":"https://example.com/hook","method":"POST"}`; string(data) != want {
+		t.Errorf("json.Marshal(settings) returned %s, want %s", data, want)
+	}
+
+	_, err = NewCreateNotificationInput("deploys", nil, &SlackSettings{})
+	if !errors.Is(err, ErrValidation) {
+		t.Errorf("NewCreateNotificationInput returned %v, want ErrValidation", err)
+	}
+
+	update, err := NewUpdateNotificationSettings(&SlackSettings{Url: "https://hooks.slack.com/services/T0/B0/X"})
+	if err != nil {
+		t.Fatalf("NewUpdateNotificationSettings returned error: %v", err)
+	}
+	testJSONMarshal(t, update, `{"url": "https://hooks.slack.com/services/T0/B0/X"}`)
+}
+
+func TestReadNotificationResult_TypedSettings(t *testing.T) {
+	var settings any
+	if err := json.Unmarshal([]byte(`{"routingKey": "key", "summary": "{{alertName}}"}`), &settings); err != nil {
+		t.Fatal(err)
+	}
+	notification := ReadNotificationResult{Id: "1", Type: NotificationTypePagerDuty, Settings: &settings}
+
+	got, err := notification.TypedSettings()
+	if err != nil {
+		t.Fatalf("TypedSettings returned error: %v", err)
+	}
+
+	want := &PagerDutySettings{RoutingKey: "key", Summary: Ptr("{{alertName}}")}
+	if !testObjects(t, got, want) {
+		t.Errorf("TypedSettings returned %+v, want %+v", got, want)
+	}
+
+	var emailSettings any = notificationsMockData.emailSettings
+	email := ReadNotificationResult{Id: "2", Type: NotificationTypeEmail, Settings: &emailSettings}
+	if got, err := email.TypedSettings(); err != nil || len(got.(*EmailSettings).Addresses) != 2 {
+		t.Errorf("TypedSettings returned %+v, %v, want 2 email addresses", got, err)
+	}
+
+	var teamsSettings any = map[string]any{"url": "https://example.webhook.office.com/webhookb2/1"}
+	teams := ReadNotificationResult{Id: "5", Type: "msTeams", Settings: &teamsSettings}
+	if got, err := teams.TypedSettings(); err != nil || !testObjects(t, got, &MsTeamsSettings{Url: "https://example.webhook.office.com/webhookb2/1"}) {
+		t.Errorf("TypedSettings returned %+v, %v, want MsTeamsSettings", got, err)
+	}
+
+	unknown := ReadNotificationResult{Id: "3", Type: "carrierpigeon", Settings: &settings}
+	if _, err := unknown.TypedSettings(); err == nil {
+		t.Error("TypedSettings expected an error for an unknown type")
+	}
+	if _, err := (&ReadNotificationResult{Id: "4", Type: NotificationTypeSlack}).TypedSettings(); err == nil {
+		t.Error("TypedSettings expected an error for missing settings")
+	}
+}
@@ -2,7 +2,7 @@ package client
 
 import (
 	"context"
-	"log"
+	"fmt"
 )
 
 type NotificationsService service
@@ -12,6 +12,8 @@ type CreateNotificationResult = createNotificationCreateNotificationServiceConfi
 
 type ReadNotificationResult = getNotificationUserAuthenticatedUserCurrentOrganizationNotificationServiceConfigurationNotificationService
 
+type TestNotificationInput = TestNotificationServiceConfigurationInput
+
 type UpdateNotificationInput = UpdateNotificationServiceConfigurationInput
 type UpdateNotificationResult = updateNotificationUpdateNotificationServiceConfigurationUpdateNotificationServiceConfigurationResponseConfigurationNotificationService
 
@@ -20,6 +22,11 @@ type NotificationsCommunicator interface {
 	Read(context.Context, string, string) (*ReadNotificationResult, error)
 	Update(context.Context, UpdateNotificationInput) (*UpdateNotificationResult, error)
 	Delete(context.Context, string) error
+	List(context.Context, NotificationServiceFilter) ([]ReadNotificationResult, error)
+	ReadByTitle(context.Context, string) (*ReadNotificationResult, error)
+	Counts(context.Context) (*NotificationCountsResult, error)
+	Test(context.Context, TestNotificationInput) (*TestNotificationResult, error)
+	TestById(context.Context, string, string) (*TestNotificationResult, error)
 }
 
 func newNotificationsService(c *Client) *NotificationsService {
@@ -28,10 +35,10 @@ func newNotificationsService(c *Client) *NotificationsService {
 
 // Creates a new notification.
 func (service *NotificationsService) Create(ctx context.Context, input CreateNotificationInput) (*CreateNotificationResult, error) {
-	log.Printf("create notification request. title: %s", input.Title)
+	service.client.logger.DebugContext(ctx, "create notification request", "title", input.Title)
 
-	resp, err := doMutate(
-		func() (*createNotificationResponse, error) {
+	resp, err := doMutate(ctx,
+		func(ctx context.Context) (*createNotificationResponse, error) {
 			return createNotification(ctx, service.client.gql, input)
 		},
 		func(resp *createNotificationResponse) error {
@@ -48,14 +55,14 @@ func (service *NotificationsService) Create(ctx context.Context, input CreateNot
 	}
 
 	notification := resp.CreateNotificationServiceConfiguration.Configuration
-	log.Printf("create notifications success. id: %s", notification.Id)
+	service.client.logger.DebugContext(ctx, "create notification success", "id", notification.Id)
 
 	return notification, nil
 }
 
 // Returns the notification identified by the given Id.
 func (service *NotificationsService) Read(ctx context.Context, id string, notificationType string) (*ReadNotificationResult, error) {
-	log.Printf("read notification request. id: %s", id)
+	service.client.logger.DebugContext(ctx, "read notification request", "id", id)
 
 	resp, err := getNotification(ctx, service.client.gql, id, notificationType)
 
@@ -65,17 +72,17 @@ func (service *NotificationsService) Read(ctx context.Context, id string, notifi
 
 	notification := resp.User.CurrentOrganization.NotificationServiceConfiguration
 
-	log.Printf("read notification success. title: %s", notification.Title)
+	service.client.logger.DebugContext(ctx, "read notification success", "title", notification.Title)
 
 	return &notification, nil
 }
 
 // Updates the notification.
 func (service *NotificationsService) Update(ctx context.Context, input UpdateNotificationInput) (*UpdateNotificationResult, error) {
-	log.Printf("update notification request. id: %s", input.Id)
+	service.client.logger.DebugContext(ctx, "update notification request", "id", input.Id)
 
-	resp, err := doMutate(
-		func() (*updateNotificationResponse, error) {
+	resp, err := doMutate(ctx,
+		func(ctx context.Context) (*updateNotificationResponse, error) {
 			return updateNotification(ctx, service.client.gql, input)
 		},
 		func(resp *updateNotificationResponse) error {
@@ -91,17 +98,17 @@ func (service *NotificationsService) Update(ctx context.Context, input UpdateNot
 		return nil, err
 	}
 
-	log.Printf("update notification success. id: %s", input.Id)
+	service.client.logger.DebugContext(ctx, "update notification success", "id", input.Id)
 
 	return resp.UpdateNotificationServiceConfiguration.Configuration, nil
 }
 
 // Deletes the notification with the given id.
 func (service *NotificationsService) Delete(ctx context.Context, id string) error {
-	log.Printf("delete notification request. id: %s", id)
+	service.client.logger.DebugContext(ctx, "delete notification request", "id", id)
 
-	_, err := doMutate(
-		func() (*deleteNotificationResponse, error) {
+	_, err := doMutate(ctx,
+		func(ctx context.Context) (*deleteNotificationResponse, error) {
 			return deleteNotification(ctx, service.client.gql, DeleteNotificationServiceConfigurationInput{
 				Id: id,
 			})
@@ -119,7 +126,127 @@ func (service *NotificationsService) Delete(ctx context.Context, id string) erro
 		return err
 	}
 
-	log.Printf("delete notification success. id: %s", id)
+	service.client.logger.DebugContext(ctx, "delete notification success", "id", id)
 
 	return nil
 }
+
+// Returns the notifications of the organization, optionally only those of the filter's type,
+// e.g. slack or webhook.
+func (service *NotificationsService) List(ctx context.Context, filter NotificationServiceFilter) ([]ReadNotificationResult, error) {
+	service.client.logger.DebugContext(ctx, "list notifications request", "type", filter.Type)
+
+	resp, err := listNotifications(ctx, service.client.gql, &filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	notifications := resp.User.CurrentOrganization.NotificationServices
+
+	service.client.logger.DebugContext(ctx, "list notifications success", "count", len(notifications))
+
+	return notifications, nil
+}
+
+// Returns the notification with the given title. Titles are compared exactly. An error is
+// returned if no notification or more than one notification has the title.
+func (service *NotificationsService) ReadByTitle(ctx context.Context, title string) (*ReadNotificationResult, error) {
+	notifications, err := service.List(ctx, NotificationServiceFilter{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []ReadNotificationResult
+	for _, notification := range notifications {
+		if notification.Title == title {
+			matches = append(matches, notification)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("notification %q: %w", title, ErrNotFound)
+	case 1:
+		return &matches[0], nil
+	default:
+		ids := make([]string, len(matches))
+		for i, match := range matches {
+			ids[i] = match.Id
+		}
+		return nil, fmt.Errorf("notification %q is the title of %d notifications: %v", title, len(matches), ids)
+	}
+}
+
+// Returns the number of notifications of each type.
+func (service *NotificationsService) Counts(ctx context.Context) (*NotificationCountsResult, error) {
+	service.client.logger.DebugContext(ctx, "get notification counts request")
+
+	resp, err := getNotificationCounts(ctx, service.client.gql)
+
+	if err != nil {
+		return nil, err
+	}
+
+	counts := resp.User.CurrentOrganization.NotificationServicesGroupCount
+
+	service.client.logger.DebugContext(ctx, "get notification counts success", "total", counts.Total())
+
+	return &counts, nil
+}
+
+// Total returns the number of notifications of every type.
+func (r *NotificationCountsResult) Total() int {
+	total := 0
+	for _, count := range []*int{r.Email, r.Slack, r.Pagerduty, r.Webhook, r.Victorops, r.Opsgenie,
+		r.Amazonsns, r.Zapier, r.MsTeams, r.Pushover, r.Sms, r.Swsd, r.Servicenow} {
+		if count != nil {
+			total += *count
+		}
+	}
+	return total
+}
+
+// Sends a test message through the notification described by the input, which is either a saved
+// notification or unsaved settings. A notification that cannot deliver the message is reported
+// by the result, not by the error; use the result's Err method to treat it as an error.
+func (service *NotificationsService) Test(ctx context.Context, input TestNotificationInput) (*TestNotificationResult, error) {
+	service.client.logger.DebugContext(ctx, "test notification request", "type", input.Type, "id", input.Id)
+
+	resp, err := testNotification(ctx, service.client.gql, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := resp.TestNotificationServiceConfiguration
+
+	service.client.logger.DebugContext(ctx, "test notification success", "type", input.Type, "delivered", result.Success)
+
+	return &result, nil
+}
+
+// Sends a test message through the saved notification with the given id and type.
+func (service *NotificationsService) TestById(ctx context.Context, id string, notificationType string) (*TestNotificationResult, error) {
+	return service.Test(ctx, TestNotificationInput{Type: notificationType, Id: &id})
+}
+
+// NewTestNotificationInput returns the input to test unsaved notification settings. The settings
+// are validated first, and the returned error wraps ErrValidation if they are invalid.
+func NewTestNotificationInput(settings NotificationSettings) (TestNotificationInput, error) {
+	value, err := NewUpdateNotificationSettings(settings)
+	if err != nil {
+		return TestNotificationInput{}, err
+	}
+
+	return TestNotificationInput{Type: settings.NotificationType(), Settings: value}, nil
+}
+
+// Err returns an error with the server's code and message if the test message was not delivered.
+func (r *TestNotificationResult) Err() error {
+	if r.Success {
+		return nil
+	}
+	return mutateError("test notification failed", r.Code, r.Message)
+}
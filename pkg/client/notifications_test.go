@@ -1,8 +1,11 @@
 package client
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -249,6 +252,123 @@ func TestSwoService_NotificationsServerErrors(t *testing.T) {
 	if err == nil {
 		t.Error("Swo.NotificationsServerErrors expected an error response")
 	}
+	_, err = client.NotificationsService().List(ctx, NotificationServiceFilter{})
+	if err == nil {
+		t.Error("Swo.NotificationsServerErrors expected an error response")
+	}
+	_, err = client.NotificationsService().Counts(ctx)
+	if err == nil {
+		t.Error("Swo.NotificationsServerErrors expected an error response")
+	}
+	_, err = client.NotificationsService().TestById(ctx, "123", "email")
+	if err == nil {
+		t.Error("Swo.NotificationsServerErrors expected an error response")
+	}
+}
+
+func mockNotificationList() []ReadNotificationResult {
+	return []ReadNotificationResult{
+		{Id: "1", Type: "slack", Title: "ops", CreatedAt: notificationsMockData.fieldCreatedAt},
+		{Id: "2", Type: "webhook", Title: "deploys", CreatedAt: notificationsMockData.fieldCreatedAt},
+		{Id: "3", Type: "slack", Title: "deploys", CreatedAt: notificationsMockData.fieldCreatedAt},
+	}
+}
+
+func sendNotificationList(t *testing.T, w http.ResponseWriter, notifications []ReadNotificationResult) {
+	sendGraphQLResponse(t, w, listNotificationsResponse{
+		User: listNotificationsUserAuthenticatedUser{
+			CurrentOrganization: listNotificationsUserAuthenticatedUserCurrentOrganization{
+				NotificationServices: notifications,
+			},
+		},
+	})
+}
+
+func TestSwoService_ListNotifications(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	filter := NotificationServiceFilter{Type: Ptr("slack")}
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__listNotificationsInput](r)
+		if err != nil {
+			t.Errorf("Swo.ListNotifications error: %v", err)
+		}
+		if !testObjects(t, gqlInput.Filter, &filter) {
+			t.Errorf("Request filter = %+v, want %+v", gqlInput.Filter, filter)
+		}
+
+		var notifications []ReadNotificationResult
+		for _, notification := range mockNotificationList() {
+			if notification.Type == *filter.Type {
+				notifications = append(notifications, notification)
+			}
+		}
+		sendNotificationList(t, w, notifications)
+	})
+
+	got, err := client.NotificationsService().List(ctx, filter)
+	if err != nil {
+		t.Fatalf("Swo.ListNotifications returned error: %v", err)
+	}
+
+	want := []ReadNotificationResult{mockNotificationList()[0], mockNotificationList()[2]}
+	if !testObjects(t, got, want) {
+		t.Errorf("Swo.ListNotifications returned %+v, wanted %+v", got, want)
+	}
+}
+
+func TestSwoService_ReadNotificationByTitle(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		sendNotificationList(t, w, mockNotificationList())
+	})
+
+	got, err := client.NotificationsService().ReadByTitle(ctx, "ops")
+	if err != nil {
+		t.Fatalf("Swo.ReadNotificationByTitle returned error: %v", err)
+	}
+	if want := &mockNotificationList()[0]; !testObjects(t, got, want) {
+		t.Errorf("Swo.ReadNotificationByTitle returned %+v, wanted %+v", got, want)
+	}
+
+	if _, err := client.NotificationsService().ReadByTitle(ctx, "missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Swo.ReadNotificationByTitle returned %v, wanted ErrNotFound", err)
+	}
+	if _, err := client.NotificationsService().ReadByTitle(ctx, "deploys"); err == nil {
+		t.Error("Swo.ReadNotificationByTitle expected an error for an ambiguous title")
+	}
+}
+
+func TestSwoService_NotificationCounts(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		sendGraphQLResponse(t, w, getNotificationCountsResponse{
+			User: getNotificationCountsUserAuthenticatedUser{
+				CurrentOrganization: getNotificationCountsUserAuthenticatedUserCurrentOrganization{
+					NotificationServicesGroupCount: NotificationCountsResult{Slack: Ptr(2), Webhook: Ptr(1)},
+				},
+			},
+		})
+	})
+
+	got, err := client.NotificationsService().Counts(ctx)
+	if err != nil {
+		t.Fatalf("Swo.NotificationCounts returned error: %v", err)
+	}
+
+	want := &NotificationCountsResult{Slack: Ptr(2), Webhook: Ptr(1)}
+	if !testObjects(t, got, want) {
+		t.Errorf("Swo.NotificationCounts returned %+v, wanted %+v", got, want)
+	}
+	if got.Total() != 3 {
+		t.Errorf("NotificationCountsResult.Total() = %d, wanted 3", got.Total())
+	}
 }
 
 func TestNotification_Marshal(t *testing.T) {
@@ -294,3 +414,85 @@ func TestNotification_Marshal(t *testing.T) {
 
 	testJSONMarshal(t, got, want)
 }
+
+func TestSwoService_TestNotification(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__testNotificationInput](r)
+		if err != nil {
+			t.Errorf("Swo.TestNotification error: %v", err)
+		}
+
+		want := __testNotificationInput{Input: TestNotificationInput{Type: "webhook", Id: Ptr("123")}}
+		if !testObjects(t, *gqlInput, want) {
+			t.Errorf("Request input = %+v, want %+v", *gqlInput, want)
+		}
+
+		sendGraphQLResponse(t, w, testNotificationResponse{
+			TestNotificationServiceConfiguration: TestNotificationResult{
+				Code:    "502",
+				Success: false,
+				Message: "webhook responded with 502",
+			},
+		})
+	})
+
+	got, err := client.NotificationsService().TestById(ctx, "123", "webhook")
+	if err != nil {
+		t.Fatalf("Swo.TestNotification returned error: %v", err)
+	}
+
+	want := &TestNotificationResult{Code: "502", Success: false, Message: "webhook responded with 502"}
+	if !testObjects(t, got, want) {
+		t.Errorf("Swo.TestNotification returned %+v, wanted %+v", got, want)
+	}
+
+	var apiErr *APIError
+	if err := got.Err(); !errors.As(err, &apiErr) || apiErr.Message != want.Message {
+		t.Errorf("TestNotificationResult.Err() = %v, wanted an APIError with the server message", err)
+	}
+	if err := (&TestNotificationResult{Success: true}).Err(); err != nil {
+		t.Errorf("TestNotificationResult.Err() = %v, wanted nil", err)
+	}
+}
+
+func TestSwoService_TestNotificationSettings(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Unset fields are left out of the input.
+		want := `"input":{"type":"slack","settings":{"url":"https://hooks.slack.com/services/T0/B0/X"}}`
+		if !strings.Contains(string(body), want) {
+			t.Errorf("Request body = %s, want it to contain %s", body, want)
+		}
+
+		sendGraphQLResponse(t, w, testNotificationResponse{
+			TestNotificationServiceConfiguration: TestNotificationResult{Code: "200", Success: true, Message: "ok"},
+		})
+	})
+
+	input, err := NewTestNotificationInput(&SlackSettings{Url: "https://hooks.slack.com/services/T0/B0/X"})
+	if err != nil {
+		t.Fatalf("NewTestNotificationInput returned error: %v", err)
+	}
+
+	got, err := client.NotificationsService().Test(ctx, input)
+	if err != nil {
+		t.Fatalf("Swo.TestNotification returned error: %v", err)
+	}
+	if got.Err() != nil {
+		t.Errorf("TestNotificationResult.Err() = %v, wanted nil", got.Err())
+	}
+
+	if _, err := NewTestNotificationInput(&SlackSettings{Url: "not a url"}); !errors.Is(err, ErrValidation) {
+		t.Errorf("NewTestNotificationInput returned %v, wanted ErrValidation", err)
+	}
+}
@@ -0,0 +1,341 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strconv"
+	"sync"
+)
+
+// ListOptions controls paging and sorting for list and search requests. A nil *ListOptions
+// leaves everything to the server defaults.
+type ListOptions struct {
+	// Paging selects the page to fetch. Paginators use First as the page size and After as
+	// the starting cursor.
+	Paging *PagingInput
+	// SortBy orders the results by one or more properties.
+	SortBy *SortInput
+	// MaxItems caps the number of items a paginator returns. Zero means no cap.
+	MaxItems int
+	// Prefetch is the number of pages a paginator fetches ahead of the caller. Zero fetches
+	// each page only when it is needed.
+	Prefetch int
+}
+
+// SortBy returns a SortInput ordering results by the given property and direction.
+func SortBy(propertyName string, direction SortDirection) *SortInput {
+	return &SortInput{
+		Sorts: []SortItemInput{
+			{PropertyName: propertyName, Direction: &direction},
+		},
+	}
+}
+
+func (o *ListOptions) paging() *PagingInput {
+	if o == nil {
+		return nil
+	}
+	return o.Paging
+}
+
+func (o *ListOptions) sortBy() *SortInput {
+	if o == nil {
+		return nil
+	}
+	return o.SortBy
+}
+
+func (o *ListOptions) paginatorOptions() []PaginatorOption {
+	if o == nil {
+		return nil
+	}
+
+	opts := []PaginatorOption{
+		MaxItemsOption(o.MaxItems),
+		PrefetchOption(o.Prefetch),
+	}
+	if o.Paging != nil {
+		if o.Paging.First != nil {
+			opts = append(opts, PageSizeOption(*o.Paging.First))
+		}
+		if o.Paging.After != nil {
+			opts = append(opts, StartCursorOption(*o.Paging.After))
+		}
+	}
+
+	return opts
+}
+
+// CursorPage is implemented by every Relay-style connection result returned by the API.
+type CursorPage interface {
+	GetPageInfo() PageInfo
+}
+
+// PageFetchFunc fetches the page selected by paging.
+type PageFetchFunc[P CursorPage] func(ctx context.Context, paging *PagingInput) (P, error)
+
+// PaginatorOption provides functional option-setting behavior for a Paginator.
+type PaginatorOption func(*paginatorConfig)
+
+type paginatorConfig struct {
+	pageSize    *int
+	startCursor *string
+	maxItems    int
+	prefetch    int
+}
+
+// PageSizeOption sets the number of items requested per page.
+func PageSizeOption(size int) PaginatorOption {
+	return func(c *paginatorConfig) {
+		if size > 0 {
+			c.pageSize = &size
+		}
+	}
+}
+
+// StartCursorOption starts paging after the given cursor instead of at the first item.
+func StartCursorOption(cursor string) PaginatorOption {
+	return func(c *paginatorConfig) {
+		if cursor != "" {
+			c.startCursor = &cursor
+		}
+	}
+}
+
+// MaxItemsOption stops paging once the given number of items has been returned.
+func MaxItemsOption(maxItems int) PaginatorOption {
+	return func(c *paginatorConfig) {
+		if maxItems > 0 {
+			c.maxItems = maxItems
+		}
+	}
+}
+
+// PrefetchOption fetches up to the given number of pages in the background while the
+// caller is still consuming earlier ones.
+func PrefetchOption(pages int) PaginatorOption {
+	return func(c *paginatorConfig) {
+		if pages > 0 {
+			c.prefetch = pages
+		}
+	}
+}
+
+// Paginator walks a cursor-paginated connection forwards, page by page. P is the page type
+// returned by the fetch function and T the type of the items on each page. Nothing is fetched
+// until Pages, Items or Collect is called, and each call starts again from the first page.
+type Paginator[P CursorPage, T any] struct {
+	ctx    context.Context
+	fetch  PageFetchFunc[P]
+	items  func(P) []T
+	config paginatorConfig
+}
+
+// NewPaginator returns a Paginator which calls fetch for each page and items to get at the
+// items on it. The context is used for every fetch and cancels paging when done.
+func NewPaginator[P CursorPage, T any](ctx context.Context, fetch PageFetchFunc[P], items func(P) []T, opts ...PaginatorOption) *Paginator[P, T] {
+	p := &Paginator[P, T]{
+		ctx:   ctx,
+		fetch: fetch,
+		items: items,
+	}
+
+	for _, opt := range opts {
+		opt(&p.config)
+	}
+
+	return p
+}
+
+type pageResult[P any] struct {
+	page P
+	err  error
+}
+
+// Pages returns an iterator over each page. Iteration stops at the first error, which is
+// yielded with a zero page.
+func (p *Paginator[P, T]) Pages() iter.Seq2[P, error] {
+	return func(yield func(P, error) bool) {
+		if p.config.prefetch == 0 {
+			p.fetchPages(p.ctx, func(r pageResult[P]) bool {
+				return yield(r.page, r.err)
+			})
+			return
+		}
+
+		ctx, cancel := context.WithCancel(p.ctx)
+		var wg sync.WaitGroup
+		defer wg.Wait()
+		defer cancel()
+
+		// The producer holds one page while blocked on send, so the buffer is one smaller
+		// than the number of pages to fetch ahead.
+		results := make(chan pageResult[P], p.config.prefetch-1)
+
+		// Set by the producer before results is closed when a result could not be delivered
+		// because the context was cancelled.
+		dropped := false
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(results)
+
+			p.fetchPages(ctx, func(r pageResult[P]) bool {
+				select {
+				case results <- r:
+					return true
+				case <-ctx.Done():
+					dropped = true
+					return false
+				}
+			})
+		}()
+
+		for r := range results {
+			if !yield(r.page, r.err) {
+				return
+			}
+		}
+
+		if dropped {
+			yield(*new(P), p.ctx.Err())
+		}
+	}
+}
+
+// Items returns an iterator over every item on every page, up to the configured maximum.
+// Iteration stops at the first error, which is yielded with a nil item.
+func (p *Paginator[P, T]) Items() iter.Seq2[*T, error] {
+	return func(yield func(*T, error) bool) {
+		count := 0
+
+		for page, err := range p.Pages() {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			items := p.items(page)
+			for i := range items {
+				if p.config.maxItems > 0 && count >= p.config.maxItems {
+					return
+				}
+				count++
+
+				if !yield(&items[i], nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Collect returns every item on every page, up to the configured maximum.
+func (p *Paginator[P, T]) Collect() ([]T, error) {
+	var result []T
+
+	for item, err := range p.Items() {
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *item)
+	}
+
+	return result, nil
+}
+
+// fetchPages fetches pages in order and passes each to emit until emit returns false, there
+// are no more pages, the item cap is reached, or an error occurs.
+func (p *Paginator[P, T]) fetchPages(ctx context.Context, emit func(pageResult[P]) bool) {
+	paging := &PagingInput{
+		First: p.config.pageSize,
+		After: p.config.startCursor,
+	}
+	fetched := 0
+
+	for {
+		if err := ctx.Err(); err != nil {
+			emit(pageResult[P]{err: err})
+			return
+		}
+
+		// Avoid fetching more items than will be returned.
+		if p.config.maxItems > 0 && paging.First != nil {
+			paging.First = Ptr(min(*paging.First, p.config.maxItems-fetched))
+		}
+
+		page, err := p.fetch(ctx, paging)
+		if err != nil {
+			emit(pageResult[P]{err: err})
+			return
+		}
+
+		if !emit(pageResult[P]{page: page}) {
+			return
+		}
+
+		fetched += len(p.items(page))
+		if p.config.maxItems > 0 && fetched >= p.config.maxItems {
+			return
+		}
+
+		pageInfo := page.GetPageInfo()
+		if !pageInfo.HasNextPage || pageInfo.EndCursor == nil {
+			return
+		}
+
+		paging = &PagingInput{
+			First: paging.First,
+			After: pageInfo.EndCursor,
+		}
+	}
+}
+
+// The page size used for offset paging when ListOptions.Paging.First is not set.
+const defaultOffsetPageSize = 100
+
+// OffsetPage is a page of a search that pages by offset and limit instead of by cursor. The
+// offset of the following page serves as its cursor, so that offset searches can be walked
+// with a Paginator like every other search.
+type OffsetPage[T any] struct {
+	Items []T
+	// Offset is the position of the first item of the page in the search results.
+	Offset int
+	// Total is the number of items in the search results. A negative Total means the search does
+	// not report its size, and paging continues until a page comes back empty.
+	Total int
+}
+
+// GetPageInfo implements CursorPage.
+func (p *OffsetPage[T]) GetPageInfo() PageInfo {
+	next := p.Offset + len(p.Items)
+	return PageInfo{
+		StartCursor:     Ptr(strconv.Itoa(p.Offset)),
+		EndCursor:       Ptr(strconv.Itoa(next)),
+		HasNextPage:     len(p.Items) > 0 && (p.Total < 0 || next < p.Total),
+		HasPreviousPage: p.Offset > 0,
+	}
+}
+
+// offsetPagination converts the paging of a Paginator to the equivalent offset pagination.
+func offsetPagination(paging *PagingInput) (*PaginationInput, error) {
+	pagination := &PaginationInput{Limit: defaultOffsetPageSize}
+	if paging == nil {
+		return pagination, nil
+	}
+
+	if paging.First != nil {
+		pagination.Limit = *paging.First
+	}
+	if paging.After != nil {
+		offset, err := strconv.Atoi(*paging.After)
+		if err != nil || offset < 0 {
+			return nil, fmt.Errorf("invalid offset cursor %q", *paging.After)
+		}
+		pagination.Offset = offset
+	}
+
+	return pagination, nil
+}
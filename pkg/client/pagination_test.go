@@ -0,0 +1,256 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+type mockPage struct {
+	Items    []int
+	PageInfo PageInfo
+}
+
+func (p *mockPage) GetPageInfo() PageInfo { return p.PageInfo }
+
+// mockPages serves the integers [0, total) in pages of the requested size, using the
+// index of the next item as the cursor.
+func mockPages(total int, fetches *atomic.Int32) PageFetchFunc[*mockPage] {
+	return func(ctx context.Context, paging *PagingInput) (*mockPage, error) {
+		fetches.Add(1)
+
+		start := 0
+		if paging.After != nil {
+			start, _ = strconv.Atoi(*paging.After)
+		}
+
+		size := 10
+		if paging.First != nil {
+			size = *paging.First
+		}
+
+		end := min(start+size, total)
+		page := &mockPage{}
+		for i := start; i < end; i++ {
+			page.Items = append(page.Items, i)
+		}
+
+		page.PageInfo.EndCursor = Ptr(strconv.Itoa(end))
+		page.PageInfo.HasNextPage = end < total
+
+		return page, nil
+	}
+}
+
+func mockPageItems(page *mockPage) []int {
+	return page.Items
+}
+
+func TestPaginator_Collect(t *testing.T) {
+	var fetches atomic.Int32
+
+	got, err := NewPaginator(context.Background(), mockPages(7, &fetches), mockPageItems, PageSizeOption(3)).Collect()
+	if err != nil {
+		t.Errorf("Paginator.Collect returned error: %v", err)
+	}
+
+	want := []int{0, 1, 2, 3, 4, 5, 6}
+
+	if !testObjects(t, got, want) {
+		t.Errorf("Paginator.Collect returned %+v, want %+v", got, want)
+	}
+	if fetches.Load() != 3 {
+		t.Errorf("Paginator.Collect fetched %d pages, want 3", fetches.Load())
+	}
+}
+
+func TestPaginator_StartCursor(t *testing.T) {
+	var fetches atomic.Int32
+
+	got, err := NewPaginator(context.Background(), mockPages(7, &fetches), mockPageItems,
+		PageSizeOption(3), StartCursorOption("5")).Collect()
+	if err != nil {
+		t.Errorf("Paginator.Collect returned error: %v", err)
+	}
+
+	want := []int{5, 6}
+
+	if !testObjects(t, got, want) {
+		t.Errorf("Paginator.Collect returned %+v, want %+v", got, want)
+	}
+}
+
+func TestPaginator_MaxItems(t *testing.T) {
+	var fetches atomic.Int32
+
+	got, err := NewPaginator(context.Background(), mockPages(100, &fetches), mockPageItems,
+		PageSizeOption(4), MaxItemsOption(6)).Collect()
+	if err != nil {
+		t.Errorf("Paginator.Collect returned error: %v", err)
+	}
+
+	want := []int{0, 1, 2, 3, 4, 5}
+
+	if !testObjects(t, got, want) {
+		t.Errorf("Paginator.Collect returned %+v, want %+v", got, want)
+	}
+	if fetches.Load() != 2 {
+		t.Errorf("Paginator.Collect fetched %d pages, want 2", fetches.Load())
+	}
+}
+
+func TestPaginator_Prefetch(t *testing.T) {
+	var fetches atomic.Int32
+
+	paginator := NewPaginator(context.Background(), mockPages(100, &fetches), mockPageItems,
+		PageSizeOption(1), PrefetchOption(3))
+
+	var got []int
+	for item, err := range paginator.Items() {
+		if err != nil {
+			t.Errorf("Paginator.Items returned error: %v", err)
+		}
+		got = append(got, *item)
+		if len(got) == 5 {
+			break
+		}
+	}
+
+	want := []int{0, 1, 2, 3, 4}
+
+	if !testObjects(t, got, want) {
+		t.Errorf("Paginator.Items returned %+v, want %+v", got, want)
+	}
+
+	// Breaking out of the loop waits for the prefetching goroutine, so the number of fetches is
+	// settled: the five consumed pages plus at most three fetched ahead.
+	if n := fetches.Load(); n < 5 || n > 8 {
+		t.Errorf("Paginator.Items fetched %d pages, want between 5 and 8", n)
+	}
+
+	got, err := paginator.Collect()
+	if err != nil {
+		t.Errorf("Paginator.Collect returned error: %v", err)
+	}
+	if len(got) != 100 {
+		t.Errorf("Paginator.Collect returned %d items, want 100", len(got))
+	}
+}
+
+func TestPaginator_ContextCancel(t *testing.T) {
+	for _, prefetch := range []int{0, 2} {
+		var fetches atomic.Int32
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		paginator := NewPaginator(ctx, mockPages(100, &fetches), mockPageItems,
+			PageSizeOption(2), PrefetchOption(prefetch))
+
+		var lastErr error
+		count := 0
+		for item, err := range paginator.Items() {
+			if err != nil {
+				lastErr = err
+				break
+			}
+			count++
+			if *item == 3 {
+				cancel()
+			}
+		}
+
+		if !errors.Is(lastErr, context.Canceled) {
+			t.Errorf("Paginator.Items(prefetch=%d) returned %v, want %v", prefetch, lastErr, context.Canceled)
+		}
+		if count >= 100 {
+			t.Errorf("Paginator.Items(prefetch=%d) did not stop after cancel", prefetch)
+		}
+	}
+}
+
+func TestPaginator_FetchError(t *testing.T) {
+	fetchErr := errors.New("fetch failed")
+	calls := 0
+
+	fetch := func(ctx context.Context, paging *PagingInput) (*mockPage, error) {
+		calls++
+		if calls == 2 {
+			return nil, fetchErr
+		}
+		return &mockPage{Items: []int{calls}, PageInfo: PageInfo{HasNextPage: true, EndCursor: Ptr("next")}}, nil
+	}
+
+	_, err := NewPaginator(context.Background(), fetch, mockPageItems).Collect()
+	if !errors.Is(err, fetchErr) {
+		t.Errorf("Paginator.Collect returned %v, want %v", err, fetchErr)
+	}
+	if calls != 2 {
+		t.Errorf("Paginator.Collect fetched %d pages, want 2", calls)
+	}
+}
+
+func TestListOptions_PaginatorOptions(t *testing.T) {
+	var fetches atomic.Int32
+	opts := &ListOptions{
+		Paging:   &PagingInput{First: Ptr(2), After: Ptr("3")},
+		MaxItems: 3,
+	}
+
+	got, err := NewPaginator(context.Background(), mockPages(10, &fetches), mockPageItems, opts.paginatorOptions()...).Collect()
+	if err != nil {
+		t.Errorf("Paginator.Collect returned error: %v", err)
+	}
+
+	want := []int{3, 4, 5}
+
+	if !testObjects(t, got, want) {
+		t.Errorf("Paginator.Collect returned %+v, want %+v", got, want)
+	}
+}
+
+func TestOffsetPagination(t *testing.T) {
+	got, err := offsetPagination(nil)
+	if err != nil {
+		t.Errorf("offsetPagination returned error: %v", err)
+	}
+	if want := (&PaginationInput{Limit: defaultOffsetPageSize}); !testObjects(t, got, want) {
+		t.Errorf("offsetPagination returned %+v, want %+v", got, want)
+	}
+
+	got, err = offsetPagination(&PagingInput{First: Ptr(10), After: Ptr("20")})
+	if err != nil {
+		t.Errorf("offsetPagination returned error: %v", err)
+	}
+	if want := (&PaginationInput{Limit: 10, Offset: 20}); !testObjects(t, got, want) {
+		t.Errorf("offsetPagination returned %+v, want %+v", got, want)
+	}
+
+	if _, err := offsetPagination(&PagingInput{After: Ptr("next")}); err == nil {
+		t.Error("offsetPagination expected an error for a cursor that is not an offset")
+	}
+}
+
+func TestOffsetPage_PageInfo(t *testing.T) {
+	page := &OffsetPage[int]{Items: []int{1, 2}, Offset: 2, Total: 5}
+	want := PageInfo{StartCursor: Ptr("2"), EndCursor: Ptr("4"), HasNextPage: true, HasPreviousPage: true}
+	if got := page.GetPageInfo(); !testObjects(t, got, want) {
+		t.Errorf("OffsetPage.GetPageInfo returned %+v, want %+v", got, want)
+	}
+
+	page = &OffsetPage[int]{Items: []int{5}, Offset: 4, Total: 5}
+	if page.GetPageInfo().HasNextPage {
+		t.Error("OffsetPage.GetPageInfo has a next page after the last item")
+	}
+
+	page = &OffsetPage[int]{Items: []int{5}, Offset: 4, Total: -1}
+	if !page.GetPageInfo().HasNextPage {
+		t.Error("OffsetPage.GetPageInfo has no next page without a total")
+	}
+
+	page = &OffsetPage[int]{Offset: 5, Total: -1}
+	if page.GetPageInfo().HasNextPage {
+		t.Error("OffsetPage.GetPageInfo has a next page after an empty page")
+	}
+}
@@ -0,0 +1,159 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// The rate and concurrency are never slowed below these fractions of their configured values.
+	minRateLimitFraction = 0.1
+	// Each successful response recovers this fraction of the configured rate after a slow down.
+	rateRecoveryFraction = 0.05
+)
+
+// rateLimitTransport is an http.RoundTripper that limits the rate and concurrency of requests
+// made by a Client. Every service shares the one transport. Both limits are halved when the
+// server responds with 429 Too Many Requests and recover gradually as requests succeed.
+type rateLimitTransport struct {
+	next http.RoundTripper
+
+	// limiter is nil when only the concurrency is limited.
+	limiter *rate.Limiter
+	maxRate rate.Limit
+
+	// maxConcurrency is zero when only the rate is limited.
+	maxConcurrency int
+
+	mu          sync.Mutex
+	concurrency int
+	inFlight    int
+	successes   int
+	released    chan struct{}
+}
+
+func newRateLimitTransport(next http.RoundTripper, requestsPerSecond float64, burst int, maxConcurrency int) *rateLimitTransport {
+	t := &rateLimitTransport{
+		next:           next,
+		maxConcurrency: maxConcurrency,
+		concurrency:    maxConcurrency,
+		released:       make(chan struct{}),
+	}
+
+	if requestsPerSecond > 0 {
+		t.maxRate = rate.Limit(requestsPerSecond)
+		t.limiter = rate.NewLimiter(t.maxRate, max(burst, 1))
+	}
+
+	return t
+}
+
+// RoundTrip implements the http.RoundTrip interface.
+func (t *rateLimitTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	ctx := request.Context()
+
+	if err := t.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer t.release()
+
+	if t.limiter != nil {
+		if err := t.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	response, err := t.next.RoundTrip(request)
+	if err == nil {
+		if response.StatusCode == http.StatusTooManyRequests {
+			t.slowDown()
+		} else {
+			t.speedUp()
+		}
+	}
+
+	return response, err
+}
+
+// acquire waits until fewer than the allowed number of requests are in flight.
+func (t *rateLimitTransport) acquire(ctx context.Context) error {
+	if t.maxConcurrency == 0 {
+		return nil
+	}
+
+	for {
+		t.mu.Lock()
+		if t.inFlight < t.concurrency {
+			t.inFlight++
+			t.mu.Unlock()
+			return nil
+		}
+		released := t.released
+		t.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-released:
+		}
+	}
+}
+
+func (t *rateLimitTransport) release() {
+	if t.maxConcurrency == 0 {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.inFlight--
+	t.wakeWaiters()
+}
+
+// wakeWaiters wakes every request waiting in acquire. The caller must hold t.mu.
+func (t *rateLimitTransport) wakeWaiters() {
+	close(t.released)
+	t.released = make(chan struct{})
+}
+
+// slowDown halves the rate and concurrency after the server reports too many requests.
+func (t *rateLimitTransport) slowDown() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.successes = 0
+
+	if t.limiter != nil {
+		t.limiter.SetLimit(max(t.limiter.Limit()/2, t.maxRate*minRateLimitFraction))
+	}
+	if t.maxConcurrency > 0 {
+		t.concurrency = max(t.concurrency/2, 1)
+	}
+}
+
+// speedUp moves the rate and concurrency back towards their configured values after a
+// successful response. The concurrency grows by one once as many requests as are currently
+// allowed have succeeded.
+func (t *rateLimitTransport) speedUp() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.limiter != nil {
+		if limit := t.limiter.Limit(); limit < t.maxRate {
+			t.limiter.SetLimit(min(limit+t.maxRate*rateRecoveryFraction, t.maxRate))
+		}
+	}
+
+	if t.maxConcurrency > 0 && t.concurrency < t.maxConcurrency {
+		t.successes++
+		if t.successes >= t.concurrency {
+			t.successes = 0
+			t.concurrency++
+			t.wakeWaiters()
+		}
+	}
+}
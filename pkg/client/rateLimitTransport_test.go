@@ -0,0 +1,187 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// roundTripFunc adapts a function to an http.RoundTripper.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func statusResponse(statusCode int) *http.Response {
+	return &http.Response{StatusCode: statusCode, Body: http.NoBody, Header: http.Header{}}
+}
+
+func newTestRequest(ctx context.Context) *http.Request {
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, "http://localhost", http.NoBody)
+	return req
+}
+
+func TestRateLimitTransport_Rate(t *testing.T) {
+	transport := newRateLimitTransport(roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		return statusResponse(http.StatusOK), nil
+	}), 20, 1, 0)
+
+	start := time.Now()
+	for range 5 {
+		if _, err := transport.RoundTrip(newTestRequest(context.Background())); err != nil {
+			t.Fatalf("rateLimitTransport.RoundTrip returned error: %v", err)
+		}
+	}
+
+	// The first request uses the burst and the other four wait 50ms each.
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Errorf("rateLimitTransport sent 5 requests in %v, want at least 150ms", elapsed)
+	}
+}
+
+func TestRateLimitTransport_MaxConcurrency(t *testing.T) {
+	var inFlight, peak atomic.Int32
+
+	transport := newRateLimitTransport(roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		n := inFlight.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		inFlight.Add(-1)
+		return statusResponse(http.StatusOK), nil
+	}), 0, 0, 3)
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = transport.RoundTrip(newTestRequest(context.Background()))
+		}()
+	}
+	wg.Wait()
+
+	if peak.Load() > 3 {
+		t.Errorf("rateLimitTransport had %d requests in flight, want at most 3", peak.Load())
+	}
+}
+
+func TestRateLimitTransport_SlowDown(t *testing.T) {
+	status := http.StatusTooManyRequests
+
+	transport := newRateLimitTransport(roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		return statusResponse(status), nil
+	}), 100, 10, 8)
+
+	_, _ = transport.RoundTrip(newTestRequest(context.Background()))
+
+	if got := transport.limiter.Limit(); got != 50 {
+		t.Errorf("rateLimitTransport rate after 429 = %v, want 50", got)
+	}
+	if transport.concurrency != 4 {
+		t.Errorf("rateLimitTransport concurrency after 429 = %d, want 4", transport.concurrency)
+	}
+
+	// Repeated throttling never stops requests entirely.
+	for range 10 {
+		_, _ = transport.RoundTrip(newTestRequest(context.Background()))
+	}
+	if got := transport.limiter.Limit(); got != 10 {
+		t.Errorf("rateLimitTransport rate after repeated 429s = %v, want 10", got)
+	}
+	if transport.concurrency != 1 {
+		t.Errorf("rateLimitTransport concurrency after repeated 429s = %d, want 1", transport.concurrency)
+	}
+
+	status = http.StatusOK
+	for range 40 {
+		_, _ = transport.RoundTrip(newTestRequest(context.Background()))
+	}
+	if got := transport.limiter.Limit(); got != 100 {
+		t.Errorf("rateLimitTransport rate after recovery = %v, want 100", got)
+	}
+	if transport.concurrency != 8 {
+		t.Errorf("rateLimitTransport concurrency after recovery = %d, want 8", transport.concurrency)
+	}
+}
+
+func TestRateLimitTransport_ContextCancel(t *testing.T) {
+	block := make(chan struct{})
+	defer close(block)
+
+	transport := newRateLimitTransport(roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		<-block
+		return statusResponse(http.StatusOK), nil
+	}), 0, 0, 1)
+
+	go func() { _, _ = transport.RoundTrip(newTestRequest(context.Background())) }()
+
+	// Wait for the first request to take the only slot.
+	for {
+		transport.mu.Lock()
+		inFlight := transport.inFlight
+		transport.mu.Unlock()
+		if inFlight == 1 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if _, err := transport.RoundTrip(newTestRequest(ctx)); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("rateLimitTransport.RoundTrip returned %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestRateLimitOption_SharedByServices(t *testing.T) {
+	ctx, _, server, serverURL, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		sendGraphQLResponse(t, w, []byte(`{"errors": [{"message": "not found", "extensions": {"code": "NOT_FOUND"}}]}`))
+	})
+
+	client, err := New("123456", BaseUrlOption(serverURL+baseURLPath+"/"), RateLimitOption(20, 1), MaxConcurrentRequestsOption(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	limiter, ok := client.transport.(*rateLimitTransport)
+	if !ok {
+		t.Fatalf("Swo.RateLimitOption expected a rate limited transport, got %T", client.transport)
+	}
+	if _, ok := limiter.next.(*apiTokenAuthTransport); !ok {
+		t.Errorf("Swo.RateLimitOption expected to wrap the api token transport, got %T", limiter.next)
+	}
+
+	// Requests from different services draw on the same bucket.
+	start := time.Now()
+	_, _ = client.DashboardsService().Read(ctx, "1")
+	_, _ = client.AlertsService().Read(ctx, "1")
+	_, _ = client.LogFilterService().Read(ctx, "1")
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+		t.Errorf("Swo.RateLimitOption sent 3 requests in %v, want at least 100ms", elapsed)
+	}
+}
+
+func TestRateLimitOption_Invalid(t *testing.T) {
+	client, err := New("123456", RateLimitOption(0, 1), MaxConcurrentRequestsOption(-1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := client.transport.(*rateLimitTransport); ok {
+		t.Error("Swo.RateLimitOption expected invalid options to leave requests unlimited")
+	}
+}
@@ -0,0 +1,250 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/cenkalti/backoff/v5"
+)
+
+const (
+	defaultRetryMaxElapsedTime  = 2 * time.Minute
+	defaultRetryMaxAttempts     = 5
+	defaultRetryInitialInterval = 500 * time.Millisecond
+	defaultRetryMaxInterval     = 30 * time.Second
+	defaultRetryMultiplier      = 2
+	defaultRetryJitter          = 0.5
+)
+
+// RetryPolicy controls how failed requests are retried. Queries are retried on transport
+// errors and on the retryable status codes. Mutations are only retried when their context
+// has been marked with WithRetryableMutation, since repeating them may not be safe.
+//
+// Zero values fall back to the defaults returned by DefaultRetryPolicy.
+type RetryPolicy struct {
+	// MaxElapsedTime is the total time allowed for a request including all retries.
+	MaxElapsedTime time.Duration
+	// MaxAttempts is the maximum number of times a request is sent. Use 1 to disable retries.
+	MaxAttempts int
+	// RetryableStatusCodes are the HTTP status codes which cause a request to be retried.
+	RetryableStatusCodes []int
+	// InitialInterval is the wait before the first retry. It grows by Multiplier on each retry
+	// up to MaxInterval.
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+	Multiplier      float64
+	// Jitter randomizes each wait by up to the given fraction, e.g. 0.5 waits between half
+	// and one and a half times the interval. Use a negative value to disable it.
+	Jitter float64
+	// IgnoreRetryAfter disables waiting for the time given in a response's Retry-After header.
+	IgnoreRetryAfter bool
+}
+
+// DefaultRetryPolicy returns the retry policy used when RetryPolicyOption is not given.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxElapsedTime: defaultRetryMaxElapsedTime,
+		MaxAttempts:    defaultRetryMaxAttempts,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		InitialInterval: defaultRetryInitialInterval,
+		MaxInterval:     defaultRetryMaxInterval,
+		Multiplier:      defaultRetryMultiplier,
+		Jitter:          defaultRetryJitter,
+	}
+}
+
+// withDefaults returns the policy with any zero values replaced by the defaults.
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	defaults := DefaultRetryPolicy()
+
+	if p.MaxElapsedTime == 0 {
+		p.MaxElapsedTime = defaults.MaxElapsedTime
+	}
+	if p.MaxAttempts == 0 {
+		p.MaxAttempts = defaults.MaxAttempts
+	}
+	if p.RetryableStatusCodes == nil {
+		p.RetryableStatusCodes = defaults.RetryableStatusCodes
+	}
+	if p.InitialInterval == 0 {
+		p.InitialInterval = defaults.InitialInterval
+	}
+	if p.MaxInterval == 0 {
+		p.MaxInterval = defaults.MaxInterval
+	}
+	if p.Multiplier == 0 {
+		p.Multiplier = defaults.Multiplier
+	}
+	if p.Jitter == 0 {
+		p.Jitter = defaults.Jitter
+	}
+
+	return p
+}
+
+func (p RetryPolicy) validate() error {
+	if p.MaxElapsedTime < 0 || p.MaxAttempts < 0 || p.InitialInterval < 0 || p.MaxInterval < 0 || p.Multiplier < 0 {
+		return errors.New("retry policy values must not be negative")
+	}
+	if p.Jitter > 1 {
+		return errors.New("retry policy jitter must not be greater than 1")
+	}
+	return nil
+}
+
+func (p RetryPolicy) newBackOff() *backoff.ExponentialBackOff {
+	b := &backoff.ExponentialBackOff{
+		InitialInterval:     p.InitialInterval,
+		RandomizationFactor: max(p.Jitter, 0),
+		Multiplier:          max(p.Multiplier, 1),
+		MaxInterval:         p.MaxInterval,
+	}
+	b.Reset()
+	return b
+}
+
+func (p RetryPolicy) isRetryableStatus(statusCode int) bool {
+	return slices.Contains(p.RetryableStatusCodes, statusCode)
+}
+
+type retryableMutationKey struct{}
+
+// WithRetryableMutation marks mutations made with the returned context as safe to retry,
+// e.g. because they are idempotent. Without it mutations are sent only once.
+func WithRetryableMutation(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retryableMutationKey{}, true)
+}
+
+func isRetryableMutation(ctx context.Context) bool {
+	retryable, _ := ctx.Value(retryableMutationKey{}).(bool)
+	return retryable
+}
+
+// retryAfter returns the wait requested by a response's Retry-After header, which holds
+// either a number of seconds or an HTTP date.
+func retryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0), true
+	}
+
+	return 0, false
+}
+
+// doWithRetry sends the request, retrying it according to the policy until it succeeds,
+// the policy is exhausted, or the request's context is done. When retries are exhausted
+// the last response or error is returned.
+func (c *gqlClient) doWithRetry(req *http.Request, send func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	ctx := req.Context()
+	policy := c.retryPolicy
+
+	info := requestInfoFrom(ctx)
+
+	maxAttempts := policy.MaxAttempts
+	if info != nil && info.mutation && !isRetryableMutation(ctx) {
+		maxAttempts = 1
+	}
+
+	start := time.Now()
+	expBackoff := policy.newBackOff()
+
+	for attempt := 1; ; attempt++ {
+		attemptReq, err := rewindRequest(req, attempt)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := send(attemptReq)
+
+		retryable := false
+		switch {
+		case err != nil:
+			// Errors caused by the caller's context are final.
+			retryable = ctx.Err() == nil
+		default:
+			retryable = policy.isRetryableStatus(resp.StatusCode)
+		}
+
+		if !retryable || attempt >= maxAttempts {
+			return resp, err
+		}
+
+		wait := expBackoff.NextBackOff()
+		if resp != nil && !policy.IgnoreRetryAfter {
+			if after, ok := retryAfter(resp, time.Now()); ok {
+				wait = after
+			}
+		}
+
+		// Give up rather than wait past the elapsed time limit or the context's deadline.
+		if time.Since(start)+wait > policy.MaxElapsedTime {
+			return resp, err
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(wait).After(deadline) {
+			return resp, err
+		}
+
+		statusCode := 0
+		attrs := append(requestLogAttrs(req, info), "attempt", attempt, "wait", wait)
+		if resp != nil {
+			statusCode = resp.StatusCode
+			attrs = append(attrs, "status", resp.StatusCode)
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		} else {
+			attrs = append(attrs, "error", err)
+		}
+		c.logger.WarnContext(ctx, "retrying request", attrs...)
+
+		if info != nil {
+			info.retries++
+		}
+		c.telemetry.recordRetry(ctx, info, attempt, wait, statusCode)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// rewindRequest returns the request to send for the given attempt, with a fresh copy of
+// the body for every attempt after the first.
+func rewindRequest(req *http.Request, attempt int) (*http.Request, error) {
+	if attempt == 1 || req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+	if req.GetBody == nil {
+		return nil, errors.New("request body cannot be resent")
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+
+	clone := req.Clone(req.Context())
+	clone.Body = body
+	return clone, nil
+}
@@ -0,0 +1,211 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// setupRetry returns a client using the given retry policy against a test server.
+func setupRetry(t *testing.T, policy RetryPolicy, handler http.HandlerFunc) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := New("123456", BaseUrlOption(server.URL), RetryPolicyOption(policy))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+func fastRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:     4,
+		InitialInterval: time.Millisecond,
+		MaxInterval:     5 * time.Millisecond,
+		Jitter:          -1,
+	}
+}
+
+func TestRetry_RetryableStatus(t *testing.T) {
+	var calls atomic.Int32
+
+	client := setupRetry(t, fastRetryPolicy(), func(w http.ResponseWriter, r *http.Request) {
+		// Each attempt must send the full request body.
+		if body, _ := io.ReadAll(r.Body); len(body) == 0 {
+			t.Error("Swo.Retry expected a request body")
+		}
+
+		if calls.Add(1) < 3 {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		sendGraphQLResponse(t, w, getDashboardByIdResponse{
+			Dashboards: &getDashboardByIdDashboardsDashboardQueries{
+				ByIdOrSystemReference: &getDashboardByIdDashboardsDashboardQueriesByIdOrSystemReferenceDashboard{Id: "123"},
+			},
+		})
+	})
+
+	got, err := client.DashboardsService().Read(context.Background(), "123")
+	if err != nil {
+		t.Fatalf("Swo.Retry returned error: %v", err)
+	}
+	if got.Id != "123" {
+		t.Errorf("Swo.Retry returned %+v, want id 123", got)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("Swo.Retry made %d attempts, want 3", calls.Load())
+	}
+}
+
+func TestRetry_MaxAttempts(t *testing.T) {
+	var calls atomic.Int32
+
+	client := setupRetry(t, fastRetryPolicy(), func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		http.Error(w, "rate limited", http.StatusTooManyRequests)
+	})
+
+	_, err := client.DashboardsService().Read(context.Background(), "123")
+	if !errors.Is(err, ErrRateLimited) {
+		t.Errorf("Swo.Retry returned %v, want %v", err, ErrRateLimited)
+	}
+	if calls.Load() != 4 {
+		t.Errorf("Swo.Retry made %d attempts, want 4", calls.Load())
+	}
+}
+
+func TestRetry_NonRetryableStatus(t *testing.T) {
+	var calls atomic.Int32
+
+	client := setupRetry(t, fastRetryPolicy(), func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		http.Error(w, "server error", http.StatusInternalServerError)
+	})
+
+	_, err := client.DashboardsService().Read(context.Background(), "123")
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusInternalServerError {
+		t.Errorf("Swo.Retry returned %v, want status 500", err)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("Swo.Retry made %d attempts, want 1", calls.Load())
+	}
+}
+
+func TestRetry_Mutations(t *testing.T) {
+	var calls atomic.Int32
+
+	client := setupRetry(t, fastRetryPolicy(), func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		http.Error(w, "unavailable", http.StatusBadGateway)
+	})
+
+	_ = client.DashboardsService().Delete(context.Background(), "123")
+	if calls.Load() != 1 {
+		t.Errorf("Swo.Retry made %d attempts for an unmarked mutation, want 1", calls.Load())
+	}
+
+	calls.Store(0)
+	_ = client.DashboardsService().Delete(WithRetryableMutation(context.Background()), "123")
+	if calls.Load() != 4 {
+		t.Errorf("Swo.Retry made %d attempts for a retryable mutation, want 4", calls.Load())
+	}
+}
+
+func TestRetry_RetryAfter(t *testing.T) {
+	var calls atomic.Int32
+	var first time.Time
+
+	policy := fastRetryPolicy()
+	policy.MaxAttempts = 2
+
+	client := setupRetry(t, policy, func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			first = time.Now()
+			w.Header().Set("Retry-After", "1")
+			http.Error(w, "rate limited", http.StatusTooManyRequests)
+			return
+		}
+		if elapsed := time.Since(first); elapsed < time.Second {
+			t.Errorf("Swo.Retry retried after %v, want at least 1s", elapsed)
+		}
+		http.Error(w, "rate limited", http.StatusTooManyRequests)
+	})
+
+	_, _ = client.DashboardsService().Read(context.Background(), "123")
+	if calls.Load() != 2 {
+		t.Errorf("Swo.Retry made %d attempts, want 2", calls.Load())
+	}
+}
+
+func TestRetry_ContextDeadline(t *testing.T) {
+	var calls atomic.Int32
+
+	policy := fastRetryPolicy()
+	policy.MaxAttempts = 100
+	policy.InitialInterval = 50 * time.Millisecond
+	policy.MaxInterval = 50 * time.Millisecond
+
+	client := setupRetry(t, policy, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 120*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.DashboardsService().Read(ctx, "123")
+	if err == nil {
+		t.Error("Swo.Retry expected an error")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Swo.Retry took %v, want it to stop at the context deadline", elapsed)
+	}
+	if n := calls.Load(); n < 1 || n > 3 {
+		t.Errorf("Swo.Retry made %d attempts, want between 1 and 3", n)
+	}
+}
+
+func TestRetry_MaxElapsedTime(t *testing.T) {
+	var calls atomic.Int32
+
+	policy := fastRetryPolicy()
+	policy.MaxAttempts = 100
+	policy.MaxElapsedTime = 30 * time.Millisecond
+	policy.InitialInterval = 20 * time.Millisecond
+	policy.MaxInterval = 20 * time.Millisecond
+
+	client := setupRetry(t, policy, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		http.Error(w, "unavailable", http.StatusGatewayTimeout)
+	})
+
+	_, err := client.DashboardsService().Read(context.Background(), "123")
+	if err == nil {
+		t.Error("Swo.Retry expected an error")
+	}
+	if n := calls.Load(); n != 2 {
+		t.Errorf("Swo.Retry made %d attempts, want 2", n)
+	}
+}
+
+func TestRetryPolicyOption_Invalid(t *testing.T) {
+	client, err := New("123456", RetryPolicyOption(RetryPolicy{MaxAttempts: -1}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !testObjects(t, client.retryPolicy, DefaultRetryPolicy()) {
+		t.Error("Swo.RetryPolicyOption expected an invalid policy to fall back to the default")
+	}
+}
@@ -0,0 +1,235 @@
+package client
+
+import (
+	"context"
+)
+
+type SavedSearchesService service
+
+type SavedSearchesCommunicator interface {
+	Create(context.Context, CreateSavedSearchInput) (*SavedSearch, error)
+	Read(context.Context, string) (*SavedSearch, error)
+	Update(context.Context, UpdateSavedSearchInput) (*SavedSearch, error)
+	Delete(context.Context, string) error
+	Search(context.Context, SearchSavedSearchesInput) ([]SavedSearch, error)
+	LogSearches(context.Context, *string) ([]SavedLogSearch, error)
+	CreateLogSearch(context.Context, CreateLogSearchInput) (*SavedLogSearch, error)
+	UpdateLogSearch(context.Context, string, string) (*SavedLogSearch, error)
+}
+
+func newSavedSearchesService(c *Client) *SavedSearchesService {
+	return &SavedSearchesService{c}
+}
+
+// Creates a new saved search.
+func (service *SavedSearchesService) Create(ctx context.Context, input CreateSavedSearchInput) (*SavedSearch, error) {
+	service.client.logger.DebugContext(ctx, "create saved search request", "name", input.Name, "context", input.Context)
+
+	resp, err := doMutate(ctx,
+		func(ctx context.Context) (*createSavedSearchResponse, error) {
+			return createSavedSearch(ctx, service.client.gql, input)
+		},
+		func(resp *createSavedSearchResponse) error {
+			if !resp.CreateSavedSearch.Success {
+				return mutateError("create saved search failed",
+					resp.CreateSavedSearch.Code,
+					resp.CreateSavedSearch.Message)
+			}
+			return nil
+		})
+
+	if err != nil {
+		return nil, err
+	}
+
+	savedSearch := resp.CreateSavedSearch.SavedSearch
+	if savedSearch == nil {
+		return nil, ErrUnknown
+	}
+	service.client.logger.DebugContext(ctx, "create saved search success", "id", savedSearch.Id)
+
+	return savedSearch, nil
+}
+
+// Returns the saved search identified by the given Id.
+func (service *SavedSearchesService) Read(ctx context.Context, id string) (*SavedSearch, error) {
+	service.client.logger.DebugContext(ctx, "read saved search request", "id", id)
+
+	resp, err := getSavedSearch(ctx, service.client.gql, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.SavedSearches == nil || resp.SavedSearches.ById == nil {
+		return nil, ErrNotFound
+	}
+	savedSearch := resp.SavedSearches.ById
+
+	service.client.logger.DebugContext(ctx, "read saved search success", "name", savedSearch.Name)
+
+	return savedSearch, nil
+}
+
+// Updates the saved search. Fields that are nil keep their current value. UpdateLastSearchAt is
+// not a pointer and is always sent: true also sets the lastSearchedAt time of the search to now.
+func (service *SavedSearchesService) Update(ctx context.Context, input UpdateSavedSearchInput) (*SavedSearch, error) {
+	service.client.logger.DebugContext(ctx, "update saved search request", "id", input.Id)
+
+	resp, err := doMutate(ctx,
+		func(ctx context.Context) (*updateSavedSearchResponse, error) {
+			return updateSavedSearch(ctx, service.client.gql, input)
+		},
+		func(resp *updateSavedSearchResponse) error {
+			if !resp.UpdateSavedSearch.Success {
+				return mutateError("update saved search failed",
+					resp.UpdateSavedSearch.Code,
+					resp.UpdateSavedSearch.Message)
+			}
+			return nil
+		})
+
+	if err != nil {
+		return nil, err
+	}
+
+	savedSearch := resp.UpdateSavedSearch.SavedSearch
+	if savedSearch == nil {
+		return nil, ErrUnknown
+	}
+	service.client.logger.DebugContext(ctx, "update saved search success", "id", input.Id)
+
+	return savedSearch, nil
+}
+
+// Deletes the saved search with the given id.
+func (service *SavedSearchesService) Delete(ctx context.Context, id string) error {
+	service.client.logger.DebugContext(ctx, "delete saved search request", "id", id)
+
+	_, err := doMutate(ctx,
+		func(ctx context.Context) (*deleteSavedSearchResponse, error) {
+			return deleteSavedSearch(ctx, service.client.gql, DeleteSavedSearchInput{
+				Id: id,
+			})
+		},
+		func(resp *deleteSavedSearchResponse) error {
+			if !resp.DeleteSavedSearch.Success {
+				return mutateError("delete saved search failed",
+					resp.DeleteSavedSearch.Code,
+					resp.DeleteSavedSearch.Message)
+			}
+			return nil
+		})
+
+	if err != nil {
+		return err
+	}
+
+	service.client.logger.DebugContext(ctx, "delete saved search success", "id", id)
+
+	return nil
+}
+
+// Returns the saved searches matching the search input, e.g. the searches of one context
+// with SavedSearchFilter.Context. The API returns every match at once.
+func (service *SavedSearchesService) Search(ctx context.Context, input SearchSavedSearchesInput) ([]SavedSearch, error) {
+	service.client.logger.DebugContext(ctx, "search saved searches request", "name", input.Name)
+
+	resp, err := searchSavedSearches(ctx, service.client.gql, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var savedSearches []SavedSearch
+	if resp.SavedSearches != nil {
+		savedSearches = resp.SavedSearches.Search.SavedSearches
+	}
+
+	service.client.logger.DebugContext(ctx, "search saved searches success", "count", len(savedSearches))
+
+	return savedSearches, nil
+}
+
+// Returns the log searches saved for the organization. A non-nil groupId returns the searches
+// of that log group only.
+func (service *SavedSearchesService) LogSearches(ctx context.Context, groupId *string) ([]SavedLogSearch, error) {
+	service.client.logger.DebugContext(ctx, "list log searches request", "groupId", groupId)
+
+	resp, err := listLogSearches(ctx, service.client.gql, LogSearchesInput{
+		GroupId: groupId,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	logSearches := resp.User.CurrentOrganization.LogSearches
+
+	service.client.logger.DebugContext(ctx, "list log searches success", "count", len(logSearches))
+
+	return logSearches, nil
+}
+
+// Saves a new log search for the organization.
+func (service *SavedSearchesService) CreateLogSearch(ctx context.Context, input CreateLogSearchInput) (*SavedLogSearch, error) {
+	service.client.logger.DebugContext(ctx, "create log search request", "name", input.Name)
+
+	resp, err := doMutate(ctx,
+		func(ctx context.Context) (*createLogSearchResponse, error) {
+			return createLogSearch(ctx, service.client.gql, input)
+		},
+		func(resp *createLogSearchResponse) error {
+			if !resp.CreateLogSearch.Success {
+				return mutateError("create log search failed",
+					resp.CreateLogSearch.Code,
+					resp.CreateLogSearch.Message)
+			}
+			return nil
+		})
+
+	if err != nil {
+		return nil, err
+	}
+
+	logSearch := resp.CreateLogSearch.SavedSearch
+	if logSearch == nil {
+		return nil, ErrUnknown
+	}
+	service.client.logger.DebugContext(ctx, "create log search success", "id", logSearch.Id)
+
+	return logSearch, nil
+}
+
+// Changes the query of the log search with the given id.
+func (service *SavedSearchesService) UpdateLogSearch(ctx context.Context, id string, query string) (*SavedLogSearch, error) {
+	service.client.logger.DebugContext(ctx, "update log search request", "id", id)
+
+	resp, err := doMutate(ctx,
+		func(ctx context.Context) (*updateLogSearchResponse, error) {
+			return updateLogSearch(ctx, service.client.gql, UpdateLogSearchInput{
+				Id:    id,
+				Query: query,
+			})
+		},
+		func(resp *updateLogSearchResponse) error {
+			if !resp.UpdateLogSearch.Success {
+				return mutateError("update log search failed",
+					resp.UpdateLogSearch.Code,
+					resp.UpdateLogSearch.Message)
+			}
+			return nil
+		})
+
+	if err != nil {
+		return nil, err
+	}
+
+	logSearch := resp.UpdateLogSearch.SavedSearch
+	if logSearch == nil {
+		return nil, ErrUnknown
+	}
+	service.client.logger.DebugContext(ctx, "update log search success", "id", id)
+
+	return logSearch, nil
+}
@@ -0,0 +1,366 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func mockSavedSearch() SavedSearch {
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	return SavedSearch{
+		Id:        "s-1",
+		Name:      "errors",
+		Query:     "severity:error",
+		Context:   SavedSearchContextLogs,
+		OwnerId:   "u-1",
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	}
+}
+
+func TestService_CreateSavedSearch(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	input := CreateSavedSearchInput{
+		Name:      "errors",
+		Query:     "severity:error",
+		IsPrivate: Ptr(false),
+		Context:   SavedSearchContextLogs,
+	}
+	want := mockSavedSearch()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__createSavedSearchInput](r)
+		if err != nil {
+			t.Errorf("Swo.CreateSavedSearch error: %v", err)
+		}
+		if !testObjects(t, gqlInput.Input, input) {
+			t.Errorf("Request got = %+v, want = %+v", gqlInput.Input, input)
+		}
+
+		sendGraphQLResponse(t, w, createSavedSearchResponse{
+			CreateSavedSearch: createSavedSearchCreateSavedSearchCreateSavedSearchResponse{
+				Success:     true,
+				SavedSearch: &want,
+			},
+		})
+	})
+
+	got, err := client.SavedSearchesService().Create(ctx, input)
+	if err != nil {
+		t.Fatalf("Swo.CreateSavedSearch returned error: %v", err)
+	}
+	if !testObjects(t, got, &want) {
+		t.Errorf("Swo.CreateSavedSearch returned %+v, wanted %+v", got, want)
+	}
+}
+
+func TestService_ReadSavedSearch(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	want := mockSavedSearch()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__getSavedSearchInput](r)
+		if err != nil {
+			t.Errorf("Swo.ReadSavedSearch error: %v", err)
+		}
+
+		var savedSearch *SavedSearch
+		if gqlInput.Id == want.Id {
+			savedSearch = &want
+		}
+		sendGraphQLResponse(t, w, getSavedSearchResponse{
+			SavedSearches: &getSavedSearchSavedSearchesSavedSearchQueries{ById: savedSearch},
+		})
+	})
+
+	got, err := client.SavedSearchesService().Read(ctx, want.Id)
+	if err != nil {
+		t.Fatalf("Swo.ReadSavedSearch returned error: %v", err)
+	}
+	if !testObjects(t, got, &want) {
+		t.Errorf("Swo.ReadSavedSearch returned %+v, wanted %+v", got, want)
+	}
+
+	if _, err := client.SavedSearchesService().Read(ctx, "missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Swo.ReadSavedSearch returned %v, wanted ErrNotFound", err)
+	}
+}
+
+func TestService_UpdateSavedSearch(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	input := UpdateSavedSearchInput{Id: "s-1", Query: Ptr("severity:error OR severity:critical")}
+	want := mockSavedSearch()
+	want.Query = *input.Query
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__updateSavedSearchInput](r)
+		if err != nil {
+			t.Errorf("Swo.UpdateSavedSearch error: %v", err)
+		}
+		if !testObjects(t, gqlInput.Input, input) {
+			t.Errorf("Request got = %+v, want = %+v", gqlInput.Input, input)
+		}
+
+		sendGraphQLResponse(t, w, updateSavedSearchResponse{
+			UpdateSavedSearch: updateSavedSearchUpdateSavedSearchUpdateSavedSearchResponse{
+				Success:     true,
+				SavedSearch: &want,
+			},
+		})
+	})
+
+	got, err := client.SavedSearchesService().Update(ctx, input)
+	if err != nil {
+		t.Fatalf("Swo.UpdateSavedSearch returned error: %v", err)
+	}
+	if !testObjects(t, got, &want) {
+		t.Errorf("Swo.UpdateSavedSearch returned %+v, wanted %+v", got, want)
+	}
+}
+
+func TestService_DeleteSavedSearch(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__deleteSavedSearchInput](r)
+		if err != nil {
+			t.Errorf("Swo.DeleteSavedSearch error: %v", err)
+		}
+		if want := (DeleteSavedSearchInput{Id: "s-1"}); !testObjects(t, gqlInput.Input, want) {
+			t.Errorf("Request got = %+v, want = %+v", gqlInput.Input, want)
+		}
+
+		sendGraphQLResponse(t, w, deleteSavedSearchResponse{
+			DeleteSavedSearch: deleteSavedSearchDeleteSavedSearchDeleteSavedSearchResponse{
+				Success: true,
+			},
+		})
+	})
+
+	if err := client.SavedSearchesService().Delete(ctx, "s-1"); err != nil {
+		t.Errorf("Swo.DeleteSavedSearch returned error: %v", err)
+	}
+}
+
+func TestService_SearchSavedSearches(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	input := SearchSavedSearchesInput{
+		Name:   Ptr("errors"),
+		Filter: &SavedSearchFilter{Context: Ptr(SavedSearchContextLogs)},
+	}
+	want := []SavedSearch{mockSavedSearch()}
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__searchSavedSearchesInput](r)
+		if err != nil {
+			t.Errorf("Swo.SearchSavedSearches error: %v", err)
+		}
+		if !testObjects(t, gqlInput.Input, input) {
+			t.Errorf("Request got = %+v, want = %+v", gqlInput.Input, input)
+		}
+
+		sendGraphQLResponse(t, w, searchSavedSearchesResponse{
+			SavedSearches: &searchSavedSearchesSavedSearchesSavedSearchQueries{
+				Search: searchSavedSearchesSavedSearchesSavedSearchQueriesSearchSearchSavedSearchesResult{
+					SavedSearches: want,
+				},
+			},
+		})
+	})
+
+	got, err := client.SavedSearchesService().Search(ctx, input)
+	if err != nil {
+		t.Fatalf("Swo.SearchSavedSearches returned error: %v", err)
+	}
+	if !testObjects(t, got, want) {
+		t.Errorf("Swo.SearchSavedSearches returned %+v, wanted %+v", got, want)
+	}
+}
+
+func TestService_LogSearches(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	want := []SavedLogSearch{
+		{Id: "l-1", Name: "errors", Query: "severity:error", Editable: true},
+		{Id: "l-2", Name: "deploys", Query: "program:deploy", HasAlerts: true},
+	}
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__listLogSearchesInput](r)
+		if err != nil {
+			t.Errorf("Swo.LogSearches error: %v", err)
+		}
+		if input := (LogSearchesInput{GroupId: Ptr("g-1")}); !testObjects(t, gqlInput.Input, input) {
+			t.Errorf("Request got = %+v, want = %+v", gqlInput.Input, input)
+		}
+
+		sendGraphQLResponse(t, w, listLogSearchesResponse{
+			User: listLogSearchesUserAuthenticatedUser{
+				CurrentOrganization: listLogSearchesUserAuthenticatedUserCurrentOrganization{LogSearches: want},
+			},
+		})
+	})
+
+	got, err := client.SavedSearchesService().LogSearches(ctx, Ptr("g-1"))
+	if err != nil {
+		t.Fatalf("Swo.LogSearches returned error: %v", err)
+	}
+	if !testObjects(t, got, want) {
+		t.Errorf("Swo.LogSearches returned %+v, wanted %+v", got, want)
+	}
+}
+
+func TestService_CreateLogSearch(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	input := CreateLogSearchInput{Name: "errors", Query: "severity:error"}
+	want := SavedLogSearch{Id: "l-1", Name: input.Name, Query: input.Query, Editable: true}
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__createLogSearchInput](r)
+		if err != nil {
+			t.Errorf("Swo.CreateLogSearch error: %v", err)
+		}
+		if !testObjects(t, gqlInput.Input, input) {
+			t.Errorf("Request got = %+v, want = %+v", gqlInput.Input, input)
+		}
+
+		sendGraphQLResponse(t, w, createLogSearchResponse{
+			CreateLogSearch: createLogSearchCreateLogSearchSavedLogSearchResponse{
+				Success:     true,
+				SavedSearch: &want,
+			},
+		})
+	})
+
+	got, err := client.SavedSearchesService().CreateLogSearch(ctx, input)
+	if err != nil {
+		t.Fatalf("Swo.CreateLogSearch returned error: %v", err)
+	}
+	if !testObjects(t, got, &want) {
+		t.Errorf("Swo.CreateLogSearch returned %+v, wanted %+v", got, want)
+	}
+}
+
+func TestService_UpdateLogSearch(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	want := SavedLogSearch{Id: "l-1", Name: "errors", Query: "severity:critical", Editable: true}
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__updateLogSearchInput](r)
+		if err != nil {
+			t.Errorf("Swo.UpdateLogSearch error: %v", err)
+		}
+		if input := (UpdateLogSearchInput{Id: "l-1", Query: "severity:critical"}); !testObjects(t, gqlInput.Input, input) {
+			t.Errorf("Request got = %+v, want = %+v", gqlInput.Input, input)
+		}
+
+		sendGraphQLResponse(t, w, updateLogSearchResponse{
+			UpdateLogSearch: updateLogSearchUpdateLogSearchSavedLogSearchResponse{
+				Success:     true,
+				SavedSearch: &want,
+			},
+		})
+	})
+
+	got, err := client.SavedSearchesService().UpdateLogSearch(ctx, "l-1", "severity:critical")
+	if err != nil {
+		t.Fatalf("Swo.UpdateLogSearch returned error: %v", err)
+	}
+	if !testObjects(t, got, &want) {
+		t.Errorf("Swo.UpdateLogSearch returned %+v, wanted %+v", got, want)
+	}
+}
+
+func TestService_SavedSearchesMutateError(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		sendGraphQLResponse(t, w, createSavedSearchResponse{
+			CreateSavedSearch: createSavedSearchCreateSavedSearchCreateSavedSearchResponse{
+				Success: false,
+				Code:    "400",
+				Message: "query is invalid",
+			},
+		})
+	})
+
+	_, err := client.SavedSearchesService().Create(ctx, CreateSavedSearchInput{})
+	if err == nil {
+		t.Error("Swo.SavedSearchesMutateError expected an error response")
+	}
+}
+
+func TestService_SavedSearchesMissingResult(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		sendGraphQLResponse(t, w, []byte(`{"data":{
+			"updateSavedSearch":{"success":true,"savedSearch":null},
+			"updateLogSearch":{"success":true,"savedSearch":null}}}`))
+	})
+
+	if _, err := client.SavedSearchesService().Update(ctx, UpdateSavedSearchInput{Id: "123"}); !errors.Is(err, ErrUnknown) {
+		t.Errorf("Swo.UpdateSavedSearch returned %v, want ErrUnknown", err)
+	}
+	if _, err := client.SavedSearchesService().UpdateLogSearch(ctx, "123", "error"); !errors.Is(err, ErrUnknown) {
+		t.Errorf("Swo.UpdateLogSearch returned %v, want ErrUnknown", err)
+	}
+}
+
+func TestService_SavedSearchesServerErrors(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", httpErrorResponse)
+
+	_, err := client.SavedSearchesService().Create(ctx, CreateSavedSearchInput{})
+	if err == nil {
+		t.Error("Swo.SavedSearchesServerErrors expected an error response")
+	}
+	_, err = client.SavedSearchesService().Read(ctx, "s-1")
+	if err == nil {
+		t.Error("Swo.SavedSearchesServerErrors expected an error response")
+	}
+	_, err = client.SavedSearchesService().Update(ctx, UpdateSavedSearchInput{})
+	if err == nil {
+		t.Error("Swo.SavedSearchesServerErrors expected an error response")
+	}
+	err = client.SavedSearchesService().Delete(ctx, "s-1")
+	if err == nil {
+		t.Error("Swo.SavedSearchesServerErrors expected an error response")
+	}
+	_, err = client.SavedSearchesService().Search(ctx, SearchSavedSearchesInput{})
+	if err == nil {
+		t.Error("Swo.SavedSearchesServerErrors expected an error response")
+	}
+	_, err = client.SavedSearchesService().LogSearches(ctx, nil)
+	if err == nil {
+		t.Error("Swo.SavedSearchesServerErrors expected an error response")
+	}
+	_, err = client.SavedSearchesService().CreateLogSearch(ctx, CreateLogSearchInput{})
+	if err == nil {
+		t.Error("Swo.SavedSearchesServerErrors expected an error response")
+	}
+	_, err = client.SavedSearchesService().UpdateLogSearch(ctx, "l-1", "")
+	if err == nil {
+		t.Error("Swo.SavedSearchesServerErrors expected an error response")
+	}
+}
@@ -0,0 +1,191 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+)
+
+const instrumentationName = "github.com/solarwinds/swo-client-go/pkg/client"
+
+// Attribute keys set on spans and metrics.
+const (
+	attrOperationName = attribute.Key("graphql.operation.name")
+	attrOperationType = attribute.Key("graphql.operation.type")
+	attrStatusCode    = attribute.Key("http.response.status_code")
+	attrErrorType     = attribute.Key("error.type")
+	attrRequestID     = attribute.Key("swo.request_id")
+	attrRetries       = attribute.Key("swo.retries")
+)
+
+// telemetry holds the instruments used to trace and measure API calls. Every instrument is a
+// no-op unless TracerProviderOption or MeterProviderOption is given.
+type telemetry struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+
+	operations metric.Int64Counter
+	errors     metric.Int64Counter
+	retries    metric.Int64Counter
+	duration   metric.Float64Histogram
+}
+
+func newTelemetry(tracerProvider trace.TracerProvider, meterProvider metric.MeterProvider) (*telemetry, error) {
+	if tracerProvider == nil {
+		tracerProvider = tracenoop.NewTracerProvider()
+	}
+	if meterProvider == nil {
+		meterProvider = metricnoop.NewMeterProvider()
+	}
+
+	t := &telemetry{
+		tracer:     tracerProvider.Tracer(instrumentationName),
+		propagator: propagation.TraceContext{},
+	}
+
+	meter := meterProvider.Meter(instrumentationName)
+
+	var err, e error
+	t.operations, e = meter.Int64Counter("swo.client.operations",
+		metric.WithDescription("Number of GraphQL operations sent to the API."),
+		metric.WithUnit("{operation}"))
+	err = errors.Join(err, e)
+
+	t.errors, e = meter.Int64Counter("swo.client.operation.errors",
+		metric.WithDescription("Number of GraphQL operations which failed."),
+		metric.WithUnit("{operation}"))
+	err = errors.Join(err, e)
+
+	t.retries, e = meter.Int64Counter("swo.client.retries",
+		metric.WithDescription("Number of times a request was retried."),
+		metric.WithUnit("{retry}"))
+	err = errors.Join(err, e)
+
+	t.duration, e = meter.Float64Histogram("swo.client.operation.duration",
+		metric.WithDescription("Duration of GraphQL operations including retries."),
+		metric.WithUnit("s"))
+	err = errors.Join(err, e)
+
+	return t, err
+}
+
+// instrumentedClient wraps a graphql.Client with a span and metrics for every operation.
+type instrumentedClient struct {
+	client    graphql.Client
+	telemetry *telemetry
+}
+
+// operationType returns the type of the GraphQL operation in the query: query, mutation
+// or subscription.
+func operationType(query string) string {
+	query = strings.TrimSpace(query)
+	for _, t := range []string{"mutation", "subscription"} {
+		if strings.HasPrefix(query, t) {
+			return t
+		}
+	}
+	return "query"
+}
+
+func (c *instrumentedClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	ctx, info := withRequestInfo(ctx)
+	operationType := operationType(req.Query)
+
+	ctx, span := c.telemetry.tracer.Start(ctx, req.OpName,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attrOperationName.String(req.OpName),
+			attrOperationType.String(operationType),
+		))
+	defer span.End()
+
+	start := time.Now()
+	err := c.client.MakeRequest(ctx, req, resp)
+	elapsed := time.Since(start)
+
+	span.SetAttributes(
+		attrRequestID.String(info.requestID),
+		attrRetries.Int(info.retries),
+	)
+	if info.statusCode != 0 {
+		span.SetAttributes(attrStatusCode.Int(info.statusCode))
+	}
+
+	attrs := []attribute.KeyValue{
+		attrOperationName.String(req.OpName),
+		attrOperationType.String(operationType),
+	}
+	if info.statusCode != 0 {
+		attrs = append(attrs, attrStatusCode.Int(info.statusCode))
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		errorType := errorType(err)
+		span.SetAttributes(attrErrorType.String(errorType))
+		attrs = append(attrs, attrErrorType.String(errorType))
+		c.telemetry.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+	}
+
+	c.telemetry.operations.Add(ctx, 1, metric.WithAttributes(attrs...))
+	c.telemetry.duration.Record(ctx, elapsed.Seconds(), metric.WithAttributes(attrs...))
+
+	return err
+}
+
+// injectTraceContext adds the trace context of the request's span to its headers.
+func (t *telemetry) injectTraceContext(req *http.Request) {
+	t.propagator.Inject(req.Context(), propagation.HeaderCarrier(req.Header))
+}
+
+// recordRetry notes a retry on the request's span and in the retry counter.
+func (t *telemetry) recordRetry(ctx context.Context, info *requestInfo, attempt int, wait time.Duration, statusCode int) {
+	attrs := []attribute.KeyValue{attribute.Int("attempt", attempt)}
+	if statusCode != 0 {
+		attrs = append(attrs, attrStatusCode.Int(statusCode))
+	}
+	trace.SpanFromContext(ctx).AddEvent("retry", trace.WithAttributes(
+		append(attrs, attribute.String("wait", wait.String()))...))
+
+	metricAttrs := []attribute.KeyValue{}
+	if info != nil {
+		metricAttrs = append(metricAttrs, attrOperationName.String(info.operation))
+	}
+	if statusCode != 0 {
+		metricAttrs = append(metricAttrs, attrStatusCode.Int(statusCode))
+	}
+	t.retries.Add(ctx, 1, metric.WithAttributes(metricAttrs...))
+}
+
+// errorType returns a low-cardinality description of the error for the error.type attribute.
+func errorType(err error) string {
+	var apiErr *APIError
+	switch {
+	case errors.As(err, &apiErr) && apiErr.Code != "":
+		return apiErr.Code
+	case errors.As(err, &apiErr) && apiErr.StatusCode != http.StatusOK:
+		return strconv.Itoa(apiErr.StatusCode)
+	case errors.As(err, &apiErr):
+		return "graphql_error"
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "deadline_exceeded"
+	default:
+		return "transport"
+	}
+}
@@ -0,0 +1,181 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func setupTelemetry(t *testing.T, opts ...ClientOption) (*Client, *http.ServeMux, *tracetest.InMemoryExporter, *sdkmetric.ManualReader) {
+	_, _, server, serverURL, teardown := setup()
+	t.Cleanup(teardown)
+
+	exporter := tracetest.NewInMemoryExporter()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	reader := sdkmetric.NewManualReader()
+	meterProvider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	opts = append([]ClientOption{
+		BaseUrlOption(serverURL + baseURLPath + "/"),
+		TracerProviderOption(tracerProvider),
+		MeterProviderOption(meterProvider),
+	}, opts...)
+
+	client, err := New("123456", opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client, server, exporter, reader
+}
+
+func spanAttribute(span sdktrace.ReadOnlySpan, key attribute.Key) (attribute.Value, bool) {
+	for _, attr := range span.Attributes() {
+		if attr.Key == key {
+			return attr.Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+func collectMetrics(t *testing.T, reader *sdkmetric.ManualReader) map[string]metricdata.Aggregation {
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatal(err)
+	}
+
+	result := map[string]metricdata.Aggregation{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			result[m.Name] = m.Data
+		}
+	}
+	return result
+}
+
+func sumValue(data metricdata.Aggregation) int64 {
+	var total int64
+	if sum, ok := data.(metricdata.Sum[int64]); ok {
+		for _, dp := range sum.DataPoints {
+			total += dp.Value
+		}
+	}
+	return total
+}
+
+func TestTelemetry_Span(t *testing.T) {
+	client, server, exporter, reader := setupTelemetry(t)
+
+	var traceparent string
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		w.Header().Set(requestIdentifier, "server-request-id")
+		sendGraphQLResponse(t, w, deleteDashboardResponse{
+			DeleteDashboard: deleteDashboardDeleteDashboardDeleteDashboardResponse{Success: true},
+		})
+	})
+
+	if err := client.DashboardsService().Delete(context.Background(), "123"); err != nil {
+		t.Fatalf("Swo.DashboardsService.Delete returned error: %v", err)
+	}
+
+	spans := exporter.GetSpans().Snapshots()
+	if len(spans) != 1 {
+		t.Fatalf("Swo.Telemetry recorded %d spans, want 1", len(spans))
+	}
+
+	span := spans[0]
+	if span.Name() != "deleteDashboard" {
+		t.Errorf("Swo.Telemetry span name = %s, want deleteDashboard", span.Name())
+	}
+	if v, _ := spanAttribute(span, attrOperationType); v.AsString() != "mutation" {
+		t.Errorf("Swo.Telemetry operation type = %s, want mutation", v.AsString())
+	}
+	if v, _ := spanAttribute(span, attrStatusCode); v.AsInt64() != http.StatusOK {
+		t.Errorf("Swo.Telemetry status code = %d, want 200", v.AsInt64())
+	}
+	if v, _ := spanAttribute(span, attrRequestID); v.AsString() != "server-request-id" {
+		t.Errorf("Swo.Telemetry request id = %s, want server-request-id", v.AsString())
+	}
+
+	// The trace context is sent to the server in the W3C traceparent header.
+	if !strings.Contains(traceparent, span.SpanContext().TraceID().String()) {
+		t.Errorf("Swo.Telemetry traceparent = %q, want trace id %s", traceparent, span.SpanContext().TraceID())
+	}
+
+	metrics := collectMetrics(t, reader)
+	if got := sumValue(metrics["swo.client.operations"]); got != 1 {
+		t.Errorf("Swo.Telemetry swo.client.operations = %d, want 1", got)
+	}
+	if _, ok := metrics["swo.client.operation.errors"]; ok {
+		t.Error("Swo.Telemetry expected no errors to be recorded")
+	}
+
+	histogram, ok := metrics["swo.client.operation.duration"].(metricdata.Histogram[float64])
+	if !ok || len(histogram.DataPoints) != 1 || histogram.DataPoints[0].Count != 1 {
+		t.Errorf("Swo.Telemetry expected one duration measurement, got %+v", metrics["swo.client.operation.duration"])
+	}
+}
+
+func TestTelemetry_ErrorsAndRetries(t *testing.T) {
+	var calls atomic.Int32
+
+	client, server, exporter, reader := setupTelemetry(t, RetryPolicyOption(RetryPolicy{
+		MaxAttempts:     3,
+		InitialInterval: time.Millisecond,
+		MaxInterval:     time.Millisecond,
+	}))
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	})
+
+	if _, err := client.DashboardsService().Read(context.Background(), "123"); err == nil {
+		t.Fatal("Swo.Telemetry expected an error")
+	}
+
+	spans := exporter.GetSpans().Snapshots()
+	if len(spans) != 1 {
+		t.Fatalf("Swo.Telemetry recorded %d spans, want 1", len(spans))
+	}
+
+	span := spans[0]
+	if span.Status().Code != codes.Error {
+		t.Errorf("Swo.Telemetry span status = %v, want %v", span.Status().Code, codes.Error)
+	}
+	if v, _ := spanAttribute(span, attrRetries); v.AsInt64() != 2 {
+		t.Errorf("Swo.Telemetry retries = %d, want 2", v.AsInt64())
+	}
+	if v, _ := spanAttribute(span, attrErrorType); v.AsString() != "503" {
+		t.Errorf("Swo.Telemetry error type = %s, want 503", v.AsString())
+	}
+
+	retryEvents := 0
+	for _, event := range span.Events() {
+		if event.Name == "retry" {
+			retryEvents++
+		}
+	}
+	if retryEvents != 2 {
+		t.Errorf("Swo.Telemetry recorded %d retry events, want 2", retryEvents)
+	}
+
+	metrics := collectMetrics(t, reader)
+	if got := sumValue(metrics["swo.client.operation.errors"]); got != 1 {
+		t.Errorf("Swo.Telemetry swo.client.operation.errors = %d, want 1", got)
+	}
+	if got := sumValue(metrics["swo.client.retries"]); got != 2 {
+		t.Errorf("Swo.Telemetry swo.client.retries = %d, want 2", got)
+	}
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+type TransactionService service
+
+type CreateTransactionResult = createTransactionMutationDemDemMutationsCreateTransactionCreateTransactionResponse
+type ReadTransactionResult = getTransactionByIdEntitiesEntityQueriesByIdTransaction
+
+type TransactionCommunicator interface {
+	Create(context.Context, CreateTransactionInput) (*CreateTransactionResult, error)
+	Read(context.Context, string) (*ReadTransactionResult, error)
+	Update(context.Context, UpdateTransactionInput) error
+	Delete(context.Context, string) error
+}
+
+func newTransactionService(c *Client) *TransactionService {
+	return &TransactionService{c}
+}
+
+// Creates a new Transaction entity with the given input.
+func (as *TransactionService) Create(ctx context.Context, input CreateTransactionInput) (*CreateTransactionResult, error) {
+	as.client.logger.DebugContext(ctx, "create transaction request", "name", input.Name)
+
+	resp, err := createTransactionMutation(ctx, as.client.gql, input)
+	if err != nil {
+		return nil, err
+	}
+
+	result := resp.Dem.CreateTransaction
+	as.client.logger.DebugContext(ctx, "create transaction success", "id", result.Id)
+
+	return &result, nil
+}
+
+// Returns the Transaction entity with the given Id.
+func (as *TransactionService) Read(ctx context.Context, id string) (*ReadTransactionResult, error) {
+	as.client.logger.DebugContext(ctx, "read transaction request", "id", id)
+
+	resp, err := getTransactionById(ctx, as.client.gql, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Entities.ById == nil {
+		return nil, ErrEntityIdNil
+	}
+
+	transactionPtr := *resp.Entities.ById
+
+	transaction, ok := transactionPtr.(*ReadTransactionResult)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type %T", transactionPtr)
+	}
+
+	return transaction, nil
+}
+
+// Updates the Transaction with input for the given id.
+func (as *TransactionService) Update(ctx context.Context, input UpdateTransactionInput) error {
+	as.client.logger.DebugContext(ctx, "update transaction request", "id", input.Id)
+
+	if _, err := updateTransactionMutation(ctx, as.client.gql, input); err != nil {
+		return err
+	}
+
+	as.client.logger.DebugContext(ctx, "update transaction success", "id", input.Id)
+	return nil
+}
+
+// Deletes the Transaction with the given id.
+func (as *TransactionService) Delete(ctx context.Context, id string) error {
+	as.client.logger.DebugContext(ctx, "delete transaction request", "id", id)
+
+	if _, err := deleteTransactionMutation(ctx, as.client.gql, DeleteDemEntityInput{id}); err != nil {
+		return err
+	}
+
+	as.client.logger.DebugContext(ctx, "delete transaction success", "id", id)
+	return nil
+}
@@ -0,0 +1,226 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+)
+
+func TestSwoService_ReadTransaction(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	// Entity results are unions, so the response is sent as raw json for the same reason as
+	// in TestSwoService_ReadUri.
+	inputJson, err := os.ReadFile("transaction_test_read.json")
+	if err != nil {
+		t.Error(err)
+	}
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__getTransactionByIdInput](r)
+		if err != nil {
+			t.Errorf("Swo.ReadTransaction error: %v", err)
+		}
+
+		got := gqlInput.Id
+		want := "123"
+
+		if !testObjects(t, got, want) {
+			t.Errorf("Request got = %+v, want = %+v", got, want)
+		}
+
+		sendGraphQLResponse(t, w, inputJson)
+	})
+
+	got, err := client.TransactionService().Read(ctx, "123")
+	if err != nil {
+		t.Errorf("Swo.ReadTransaction returned error: %v", err)
+	}
+
+	var data getTransactionByIdResponse
+	resp := &graphql.Response{Data: &data}
+
+	// Decode the json test response so we can compare it to the server response.
+	if err = json.NewDecoder(bytes.NewReader(inputJson)).Decode(resp); err != nil {
+		t.Errorf("Swo.ReadTransaction marshal error: %v", err)
+	}
+
+	// Pull the wanted value out of the interface.
+	result := *resp.Data.(*getTransactionByIdResponse).Entities.ById
+	want := result.(*getTransactionByIdEntitiesEntityQueriesByIdTransaction)
+
+	if !testObjects(t, got, want) {
+		t.Errorf("Swo.ReadTransaction returned %+v, wanted %+v", got, want)
+	}
+}
+
+func TestSwoService_ReadTransactionUnexpectedType(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		sendGraphQLResponse(t, w, []byte(`{"data":{"entities":{"byId":{"__typename":"Uri"}}}}`))
+	})
+
+	if _, err := client.TransactionService().Read(ctx, "123"); err == nil {
+		t.Error("Swo.ReadTransactionUnexpectedType expected an error response")
+	}
+}
+
+func TestSwoService_CreateTransaction(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	input, err := GetObjectFromFile[CreateTransactionInput]("transaction_test_create.json")
+	if err != nil {
+		t.Errorf("Swo.CreateTransaction error: %v", err)
+	}
+
+	id := uuid.NewString()
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__createTransactionMutationInput](r)
+		if err != nil {
+			t.Errorf("Swo.CreateTransaction error: %v", err)
+		}
+
+		got := gqlInput.Input
+		want := *input
+
+		if !testObjects(t, got, want) {
+			t.Errorf("Request got = %+v, want = %+v", got, want)
+		}
+
+		sendGraphQLResponse(t, w, createTransactionMutationResponse{
+			Dem: createTransactionMutationDemDemMutations{
+				CreateTransaction: createTransactionMutationDemDemMutationsCreateTransactionCreateTransactionResponse{
+					Id: id,
+				},
+			},
+		})
+	})
+
+	got, err := client.TransactionService().Create(ctx, *input)
+	if err != nil {
+		t.Errorf("Swo.CreateTransaction returned error: %v", err)
+	}
+
+	want := &CreateTransactionResult{
+		Id: id,
+	}
+
+	if !testObjects(t, got, want) {
+		t.Errorf("Swo.CreateTransaction returned %+v, want %+v", got, want)
+	}
+}
+
+func TestSwoService_UpdateTransaction(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	input := UpdateTransactionInput{
+		Id:   "123",
+		Name: "swo-client-go test transaction",
+		TestDefinition: TransactionTestDefinitionInput{
+			TestFrom: ProbeLocationInput{
+				Type:   ProbeLocationTypeRegion,
+				Values: []string{"NA"},
+			},
+			TestIntervalInSeconds: 1800,
+			WindowSize:            WindowSizeInput{Width: 1280, Height: 1024},
+			Commands: []TransactionCommandInput{
+				{Command: TransactionCommandNameOpen, Target: Ptr("https://www.solarwinds.com")},
+			},
+		},
+	}
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__updateTransactionMutationInput](r)
+		if err != nil {
+			t.Errorf("Swo.UpdateTransaction error: %v", err)
+		}
+
+		got := gqlInput.Input
+		want := input
+
+		if !testObjects(t, got, want) {
+			t.Errorf("Request got = %+v, want = %+v", got, want)
+		}
+
+		sendGraphQLResponse(t, w, updateTransactionMutationResponse{
+			Dem: updateTransactionMutationDemDemMutations{
+				UpdateTransaction: updateTransactionMutationDemDemMutationsUpdateTransactionUpdateTransactionResponse{
+					Id: input.Id,
+				},
+			},
+		})
+	})
+
+	if err := client.TransactionService().Update(ctx, input); err != nil {
+		t.Errorf("Swo.UpdateTransaction returned error: %v", err)
+	}
+}
+
+func TestSwoService_DeleteTransaction(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	input := "123"
+
+	server.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		gqlInput, err := getGraphQLInput[__deleteTransactionMutationInput](r)
+		if err != nil {
+			t.Errorf("Swo.DeleteTransaction error: %v", err)
+		}
+
+		got := gqlInput.Input.Id
+		want := input
+
+		if !testObjects(t, got, want) {
+			t.Errorf("Swo.DeleteTransaction: Request got = %+v, want %+v", got, want)
+		}
+
+		sendGraphQLResponse(t, w, deleteTransactionMutationResponse{
+			Dem: deleteTransactionMutationDemDemMutations{
+				DeleteTransaction: deleteTransactionMutationDemDemMutationsDeleteTransactionDeleteDemEntityResponse{
+					Id: input,
+				},
+			},
+		})
+	})
+
+	err := client.TransactionService().Delete(ctx, input)
+	if err != nil {
+		t.Errorf("Swo.DeleteTransaction returned error: %v", err)
+	}
+}
+
+func TestSwoService_TransactionServerErrors(t *testing.T) {
+	ctx, client, server, _, teardown := setup()
+	defer teardown()
+
+	server.HandleFunc("/", httpErrorResponse)
+
+	_, err := client.TransactionService().Create(ctx, CreateTransactionInput{})
+	if err == nil {
+		t.Error("Swo.TransactionServerErrors expected an error response")
+	}
+	_, err = client.TransactionService().Read(ctx, "123")
+	if err == nil {
+		t.Error("Swo.TransactionServerErrors expected an error response")
+	}
+	err = client.TransactionService().Update(ctx, UpdateTransactionInput{})
+	if err == nil {
+		t.Error("Swo.TransactionServerErrors expected an error response")
+	}
+	err = client.TransactionService().Delete(ctx, "123")
+	if err == nil {
+		t.Error("Swo.TransactionServerErrors expected an error response")
+	}
+}
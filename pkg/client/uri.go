@@ -3,7 +3,6 @@ package client
 import (
 	"context"
 	"fmt"
-	"log"
 )
 
 type UriService service
@@ -25,7 +24,7 @@ func newUriService(c *Client) *UriService {
 
 // Creates a new Uri entity with the given input.
 func (as *UriService) Create(ctx context.Context, input CreateUriInput) (*CreateUriResult, error) {
-	log.Printf("create Uri request. name=%s, url=%s", input.Name, input.IpOrDomain)
+	as.client.logger.DebugContext(ctx, "create uri request", "name", input.Name, "url", input.IpOrDomain)
 
 	resp, err := createUriMutation(ctx, as.client.gql, input)
 	if err != nil {
@@ -33,14 +32,14 @@ func (as *UriService) Create(ctx context.Context, input CreateUriInput) (*Create
 	}
 
 	result := resp.Dem.CreateUri
-	log.Printf("create Uri success. id=%s", result.Id)
+	as.client.logger.DebugContext(ctx, "create uri success", "id", result.Id)
 
 	return &result, nil
 }
 
 // Returns the Uri entity with the given Id.
 func (as *UriService) Read(ctx context.Context, id string) (*ReadUriResult, error) {
-	log.Printf("read uri request. id=%s", id)
+	as.client.logger.DebugContext(ctx, "read uri request", "id", id)
 
 	resp, err := getUriById(ctx, as.client.gql, id)
 	if err != nil {
@@ -63,24 +62,24 @@ func (as *UriService) Read(ctx context.Context, id string) (*ReadUriResult, erro
 
 // Updates the Uri with input for the given id.
 func (as *UriService) Update(ctx context.Context, input UpdateUriInput) error {
-	log.Printf("update uri request. id=%s", input.Id)
+	as.client.logger.DebugContext(ctx, "update uri request", "id", input.Id)
 
 	if _, err := updateUriMutation(ctx, as.client.gql, input); err != nil {
 		return err
 	}
 
-	log.Printf("update uri success. id=%s", input.Id)
+	as.client.logger.DebugContext(ctx, "update uri success", "id", input.Id)
 	return nil
 }
 
 // Deletes the Uri with the given id.
 func (as *UriService) Delete(ctx context.Context, id string) error {
-	log.Printf("delete uri request. id=%s", id)
+	as.client.logger.DebugContext(ctx, "delete uri request", "id", id)
 
 	if _, err := deleteUriMutation(ctx, as.client.gql, DeleteUriInput{id}); err != nil {
 		return err
 	}
 
-	log.Printf("delete uri success. id=%s", id)
+	as.client.logger.DebugContext(ctx, "delete uri success", "id", id)
 	return nil
 }
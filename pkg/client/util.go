@@ -1,35 +1,39 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
-	"net/http/httputil"
 	"os"
 )
 
-type mutateHandler[T any] func() (T, error)
+type mutateHandler[T any] func(context.Context) (T, error)
 type responseHandler[T any] func(resp T) error
 
-func doMutate[T any](mutate mutateHandler[T], verify responseHandler[T]) (T, error) {
-	resp, err := mutate()
+func doMutate[T any](ctx context.Context, mutate mutateHandler[T], verify responseHandler[T]) (T, error) {
+	ctx, info := withRequestInfo(ctx)
+
+	resp, err := mutate(ctx)
 	if err != nil {
 		return *new(T), err
 	}
 
 	err = verify(resp)
 	if err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) {
+			apiErr.Operation = info.operation
+			apiErr.RequestID = info.requestID
+		}
 		return *new(T), err
 	}
 
 	return resp, nil
 }
 
-func mutateError(localMessage string, serverCode string, serverMessage string) error {
-	return fmt.Errorf("%s. code: %s message: %s", localMessage, serverCode, serverMessage)
-}
-
 func Ptr[T any](v T) *T {
 	return &v
 }
@@ -63,32 +67,33 @@ func GetObjectFromFile[T any](file string) (*T, error) {
 	return &obj, nil
 }
 
-// A debugging function which dumps the HTTP response to stdout.
+// A debugging function which dumps the HTTP response to stdout. Secrets are redacted.
 func DumpResponse(resp *http.Response) {
 	fmt.Printf("response status: %s\n", resp.Status)
-	dump, err := httputil.DumpResponse(resp, true)
+	dump, err := dumpResponse(resp)
 
 	if err != nil {
 		log.Printf("error dumping response: %s", err)
 		return
 	}
 
-	log.Printf("response body: %s\n\n", string(dump))
+	log.Printf("response body: %s\n\n", dump)
 }
 
-// A debugging function which dumps the HTTP request to stdout.
+// A debugging function which dumps the HTTP request to stdout. The Authorization header and
+// secret fields are redacted.
 func DumpRequest(req *http.Request) {
 	if req.Body == nil {
 		return
 	}
 
-	dump, err := httputil.DumpRequestOut(req, true)
+	dump, err := dumpRequest(req)
 	if err != nil {
 		log.Printf("error dumping request: %s", err)
 		return
 	}
 
-	log.Printf("request body: %s\n\n", string(dump))
+	log.Printf("request body: %s\n\n", dump)
 }
 
 func sliceValueExists(s []string, str string) bool {
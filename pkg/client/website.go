@@ -3,7 +3,6 @@ package client
 import (
 	"context"
 	"fmt"
-	"log"
 )
 
 type WebsiteService service
@@ -25,7 +24,7 @@ func newWebsiteService(c *Client) *WebsiteService {
 
 // Creates a new website entity with the given input.
 func (as *WebsiteService) Create(ctx context.Context, input CreateWebsiteInput) (*CreateWebsiteResult, error) {
-	log.Printf("create website request. name=%s, url=%s", input.Name, input.Url)
+	as.client.logger.DebugContext(ctx, "create website request", "name", input.Name, "url", input.Url)
 
 	resp, err := createWebsiteMutation(ctx, as.client.gql, input)
 
@@ -34,14 +33,14 @@ func (as *WebsiteService) Create(ctx context.Context, input CreateWebsiteInput)
 	}
 
 	website := resp.Dem.CreateWebsite
-	log.Printf("create website success. id=%s", website.Id)
+	as.client.logger.DebugContext(ctx, "create website success", "id", website.Id)
 
 	return &website, nil
 }
 
 // Returns the website entity with the given Id.
 func (as *WebsiteService) Read(ctx context.Context, id string) (*ReadWebsiteResult, error) {
-	log.Printf("read website request. Id: %s", id)
+	as.client.logger.DebugContext(ctx, "read website request", "id", id)
 
 	resp, err := getWebsiteById(ctx, as.client.gql, id)
 	if err != nil {
@@ -64,24 +63,24 @@ func (as *WebsiteService) Read(ctx context.Context, id string) (*ReadWebsiteResu
 
 // Updates the website with input for the given id.
 func (as *WebsiteService) Update(ctx context.Context, input UpdateWebsiteInput) error {
-	log.Printf("update website request. id=%s", input.Id)
+	as.client.logger.DebugContext(ctx, "update website request", "id", input.Id)
 
 	if _, err := updateWebsiteMutation(ctx, as.client.gql, input); err != nil {
 		return err
 	}
 
-	log.Printf("update website success. id=%s", input.Id)
+	as.client.logger.DebugContext(ctx, "update website success", "id", input.Id)
 	return nil
 }
 
 // Deletes the website with the given id.
 func (as *WebsiteService) Delete(ctx context.Context, id string) error {
-	log.Printf("delete website request. id=%s", id)
+	as.client.logger.DebugContext(ctx, "delete website request", "id", id)
 
 	if _, err := deleteWebsiteMutation(ctx, as.client.gql, DeleteWebsiteInput{id}); err != nil {
 		return err
 	}
 
-	log.Printf("delete website success. id=%s", id)
+	as.client.logger.DebugContext(ctx, "delete website success", "id", id)
 	return nil
 }